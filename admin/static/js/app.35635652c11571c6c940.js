@@ -1,2 +0,0 @@
-webpackJsonp([1],{"/wAz":function(e,t){},0:function(e,t){},"01m3":function(e,t,a){"use strict";var n=a("Zx67"),s=a.n(n),r=a("Zrlr"),c=a.n(r),i=a("zwoO"),f=a.n(i),o=a("Pf15"),d=a.n(o),u=function(e){function t(){c()(this,t);var e=f()(this,(t.__proto__||s()(t)).call(this));return e.name="ChecksumError",e.message="Invalid checksum",e}return d()(t,e),t}(Error);t.a=u},1:function(e,t){},2:function(e,t){},"2zpr":function(e,t,a){var n={"./pages/Account":"MCrN","./pages/Account.vue":"MCrN","./pages/Actions":"B7WQ","./pages/Actions.vue":"B7WQ","./pages/Luckylist":"TYnV","./pages/Luckylist.vue":"TYnV","./pages/Publish":"oQD1","./pages/Publish.vue":"oQD1","./pages/Statistics":"xfme","./pages/Statistics.vue":"xfme"};function s(e){return a(r(e))}function r(e){var t=n[e];if(!(t+1))throw new Error("Cannot find module '"+e+"'.");return t}s.keys=function(){return Object.keys(n)},s.resolve=r,e.exports=s,s.id="2zpr"},3:function(e,t){},"4Vh3":function(e,t){e.exports={modp1:{gen:"02",prime:"ffffffffffffffffc90fdaa22168c234c4c6628b80dc1cd129024e088a67cc74020bbea63b139b22514a08798e3404ddef9519b3cd3a431b302b0a6df25f14374fe1356d6d51c245e485b576625e7ec6f44c42e9a63a3620ffffffffffffffff"},modp2:{gen:"02",prime:"ffffffffffffffffc90fdaa22168c234c4c6628b80dc1cd129024e088a67cc74020bbea63b139b22514a08798e3404ddef9519b3cd3a431b302b0a6df25f14374fe1356d6d51c245e485b576625e7ec6f44c42e9a637ed6b0bff5cb6f406b7edee386bfb5a899fa5ae9f24117c4b1fe649286651ece65381ffffffffffffffff"},modp5:{gen:"02",prime:"ffffffffffffffffc90fdaa22168c234c4c6628b80dc1cd129024e088a67cc74020bbea63b139b22514a08798e3404ddef9519b3cd3a431b302b0a6df25f14374fe1356d6d51c245e485b576625e7ec6f44c42e9a637ed6b0bff5cb6f406b7edee386bfb5a899fa5ae9f24117c4b1fe649286651ece45b3dc2007cb8a163bf0598da48361c55d39a69163fa8fd24cf5f83655d23dca3ad961c62f356208552bb9ed529077096966d670c354e4abc9804f1746c08ca237327ffffffffffffffff"},modp14:{gen:"02",prime:"ffffffffffffffffc90fdaa22168c234c4c6628b80dc1cd129024e088a67cc74020bbea63b139b22514a08798e3404ddef9519b3cd3a431b302b0a6df25f14374fe1356d6d51c245e485b576625e7ec6f44c42e9a637ed6b0bff5cb6f406b7edee386bfb5a899fa5ae9f24117c4b1fe649286651ece45b3dc2007cb8a163bf0598da48361c55d39a69163fa8fd24cf5f83655d23dca3ad961c62f356208552bb9ed529077096966d670c354e4abc9804f1746c08ca18217c32905e462e36ce3be39e772c180e86039b2783a2ec07a28fb5c55df06f4c52c9de2bcbf6955817183995497cea956ae515d2261898fa051015728e5a8aacaa68ffffffffffffffff"},modp15:{gen:"02",prime:"ffffffffffffffffc90fdaa22168c234c4c6628b80dc1cd129024e088a67cc74020bbea63b139b22514a08798e3404ddef9519b3cd3a431b302b0a6df25f14374fe1356d6d51c245e485b576625e7ec6f44c42e9a637ed6b0bff5cb6f406b7edee386bfb5a899fa5ae9f24117c4b1fe649286651ece45b3dc2007cb8a163bf0598da48361c55d39a69163fa8fd24cf5f83655d23dca3ad961c62f356208552bb9ed529077096966d670c354e4abc9804f1746c08ca18217c32905e462e36ce3be39e772c180e86039b2783a2ec07a28fb5c55df06f4c52c9de2bcbf6955817183995497cea956ae515d2261898fa051015728e5a8aaac42dad33170d04507a33a85521abdf1cba64ecfb850458dbef0a8aea71575d060c7db3970f85a6e1e4c7abf5ae8cdb0933d71e8c94e04a25619dcee3d2261ad2ee6bf12ffa06d98a0864d87602733ec86a64521f2b18177b200cbbe117577a615d6c770988c0bad946e208e24fa074e5ab3143db5bfce0fd108e4b82d120a93ad2caffffffffffffffff"},modp16:{gen:"02",prime:"ffffffffffffffffc90fdaa22168c234c4c6628b80dc1cd129024e088a67cc74020bbea63b139b22514a08798e3404ddef9519b3cd3a431b302b0a6df25f14374fe1356d6d51c245e485b576625e7ec6f44c42e9a637ed6b0bff5cb6f406b7edee386bfb5a899fa5ae9f24117c4b1fe649286651ece45b3dc2007cb8a163bf0598da48361c55d39a69163fa8fd24cf5f83655d23dca3ad961c62f356208552bb9ed529077096966d670c354e4abc9804f1746c08ca18217c32905e462e36ce3be39e772c180e86039b2783a2ec07a28fb5c55df06f4c52c9de2bcbf6955817183995497cea956ae515d2261898fa051015728e5a8aaac42dad33170d04507a33a85521abdf1cba64ecfb850458dbef0a8aea71575d060c7db3970f85a6e1e4c7abf5ae8cdb0933d71e8c94e04a25619dcee3d2261ad2ee6bf12ffa06d98a0864d87602733ec86a64521f2b18177b200cbbe117577a615d6c770988c0bad946e208e24fa074e5ab3143db5bfce0fd108e4b82d120a92108011a723c12a787e6d788719a10bdba5b2699c327186af4e23c1a946834b6150bda2583e9ca2ad44ce8dbbbc2db04de8ef92e8efc141fbecaa6287c59474e6bc05d99b2964fa090c3a2233ba186515be7ed1f612970cee2d7afb81bdd762170481cd0069127d5b05aa993b4ea988d8fddc186ffb7dc90a6c08f4df435c934063199ffffffffffffffff"},modp17:{gen:"02",prime:"ffffffffffffffffc90fdaa22168c234c4c6628b80dc1cd129024e088a67cc74020bbea63b139b22514a08798e3404ddef9519b3cd3a431b302b0a6df25f14374fe1356d6d51c245e485b576625e7ec6f44c42e9a637ed6b0bff5cb6f406b7edee386bfb5a899fa5ae9f24117c4b1fe649286651ece45b3dc2007cb8a163bf0598da48361c55d39a69163fa8fd24cf5f83655d23dca3ad961c62f356208552bb9ed529077096966d670c354e4abc9804f1746c08ca18217c32905e462e36ce3be39e772c180e86039b2783a2ec07a28fb5c55df06f4c52c9de2bcbf6955817183995497cea956ae515d2261898fa051015728e5a8aaac42dad33170d04507a33a85521abdf1cba64ecfb850458dbef0a8aea71575d060c7db3970f85a6e1e4c7abf5ae8cdb0933d71e8c94e04a25619dcee3d2261ad2ee6bf12ffa06d98a0864d87602733ec86a64521f2b18177b200cbbe117577a615d6c770988c0bad946e208e24fa074e5ab3143db5bfce0fd108e4b82d120a92108011a723c12a787e6d788719a10bdba5b2699c327186af4e23c1a946834b6150bda2583e9ca2ad44ce8dbbbc2db04de8ef92e8efc141fbecaa6287c59474e6bc05d99b2964fa090c3a2233ba186515be7ed1f612970cee2d7afb81bdd762170481cd0069127d5b05aa993b4ea988d8fddc186ffb7dc90a6c08f4df435c93402849236c3fab4d27c7026c1d4dcb2602646dec9751e763dba37bdf8ff9406ad9e530ee5db382f413001aeb06a53ed9027d831179727b0865a8918da3edbebcf9b14ed44ce6cbaced4bb1bdb7f1447e6cc254b332051512bd7af426fb8f401378cd2bf5983ca01c64b92ecf032ea15d1721d03f482d7ce6e74fef6d55e702f46980c82b5a84031900b1c9e59e7c97fbec7e8f323a97a7e36cc88be0f1d45b7ff585ac54bd407b22b4154aacc8f6d7ebf48e1d814cc5ed20f8037e0a79715eef29be32806a1d58bb7c5da76f550aa3d8a1fbff0eb19ccb1a313d55cda56c9ec2ef29632387fe8d76e3c0468043e8f663f4860ee12bf2d5b0b7474d6e694f91e6dcc4024ffffffffffffffff"},modp18:{gen:"02",prime:"ffffffffffffffffc90fdaa22168c234c4c6628b80dc1cd129024e088a67cc74020bbea63b139b22514a08798e3404ddef9519b3cd3a431b302b0a6df25f14374fe1356d6d51c245e485b576625e7ec6f44c42e9a637ed6b0bff5cb6f406b7edee386bfb5a899fa5ae9f24117c4b1fe649286651ece45b3dc2007cb8a163bf0598da48361c55d39a69163fa8fd24cf5f83655d23dca3ad961c62f356208552bb9ed529077096966d670c354e4abc9804f1746c08ca18217c32905e462e36ce3be39e772c180e86039b2783a2ec07a28fb5c55df06f4c52c9de2bcbf6955817183995497cea956ae515d2261898fa051015728e5a8aaac42dad33170d04507a33a85521abdf1cba64ecfb850458dbef0a8aea71575d060c7db3970f85a6e1e4c7abf5ae8cdb0933d71e8c94e04a25619dcee3d2261ad2ee6bf12ffa06d98a0864d87602733ec86a64521f2b18177b200cbbe117577a615d6c770988c0bad946e208e24fa074e5ab3143db5bfce0fd108e4b82d120a92108011a723c12a787e6d788719a10bdba5b2699c327186af4e23c1a946834b6150bda2583e9ca2ad44ce8dbbbc2db04de8ef92e8efc141fbecaa6287c59474e6bc05d99b2964fa090c3a2233ba186515be7ed1f612970cee2d7afb81bdd762170481cd0069127d5b05aa993b4ea988d8fddc186ffb7dc90a6c08f4df435c93402849236c3fab4d27c7026c1d4dcb2602646dec9751e763dba37bdf8ff9406ad9e530ee5db382f413001aeb06a53ed9027d831179727b0865a8918da3edbebcf9b14ed44ce6cbaced4bb1bdb7f1447e6cc254b332051512bd7af426fb8f401378cd2bf5983ca01c64b92ecf032ea15d1721d03f482d7ce6e74fef6d55e702f46980c82b5a84031900b1c9e59e7c97fbec7e8f323a97a7e36cc88be0f1d45b7ff585ac54bd407b22b4154aacc8f6d7ebf48e1d814cc5ed20f8037e0a79715eef29be32806a1d58bb7c5da76f550aa3d8a1fbff0eb19ccb1a313d55cda56c9ec2ef29632387fe8d76e3c0468043e8f663f4860ee12bf2d5b0b7474d6e694f91e6dbe115974a3926f12fee5e438777cb6a932df8cd8bec4d073b931ba3bc832b68d9dd300741fa7bf8afc47ed2576f6936ba424663aab639c5ae4f5683423b4742bf1c978238f16cbe39d652de3fdb8befc848ad922222e04a4037c0713eb57a81a23f0c73473fc646cea306b4bcbc8862f8385ddfa9d4b7fa2c087e879683303ed5bdd3a062b3cf5b3a278a66d2a13f83f44f82ddf310ee074ab6a364597e899a0255dc164f31cc50846851df9ab48195ded7ea1b1d510bd7ee74d73faf36bc31ecfa268359046f4eb879f924009438b481c6cd7889a002ed5ee382bc9190da6fc026e479558e4475677e9aa9e3050e2765694dfc81f56e880b96e7160c980dd98edd3dfffffffffffffffff"}}},"6ZSt":function(e,t){e.exports={"aes-128-ecb":{cipher:"AES",key:128,iv:0,mode:"ECB",type:"block"},"aes-192-ecb":{cipher:"AES",key:192,iv:0,mode:"ECB",type:"block"},"aes-256-ecb":{cipher:"AES",key:256,iv:0,mode:"ECB",type:"block"},"aes-128-cbc":{cipher:"AES",key:128,iv:16,mode:"CBC",type:"block"},"aes-192-cbc":{cipher:"AES",key:192,iv:16,mode:"CBC",type:"block"},"aes-256-cbc":{cipher:"AES",key:256,iv:16,mode:"CBC",type:"block"},aes128:{cipher:"AES",key:128,iv:16,mode:"CBC",type:"block"},aes192:{cipher:"AES",key:192,iv:16,mode:"CBC",type:"block"},aes256:{cipher:"AES",key:256,iv:16,mode:"CBC",type:"block"},"aes-128-cfb":{cipher:"AES",key:128,iv:16,mode:"CFB",type:"stream"},"aes-192-cfb":{cipher:"AES",key:192,iv:16,mode:"CFB",type:"stream"},"aes-256-cfb":{cipher:"AES",key:256,iv:16,mode:"CFB",type:"stream"},"aes-128-cfb8":{cipher:"AES",key:128,iv:16,mode:"CFB8",type:"stream"},"aes-192-cfb8":{cipher:"AES",key:192,iv:16,mode:"CFB8",type:"stream"},"aes-256-cfb8":{cipher:"AES",key:256,iv:16,mode:"CFB8",type:"stream"},"aes-128-cfb1":{cipher:"AES",key:128,iv:16,mode:"CFB1",type:"stream"},"aes-192-cfb1":{cipher:"AES",key:192,iv:16,mode:"CFB1",type:"stream"},"aes-256-cfb1":{cipher:"AES",key:256,iv:16,mode:"CFB1",type:"stream"},"aes-128-ofb":{cipher:"AES",key:128,iv:16,mode:"OFB",type:"stream"},"aes-192-ofb":{cipher:"AES",key:192,iv:16,mode:"OFB",type:"stream"},"aes-256-ofb":{cipher:"AES",key:256,iv:16,mode:"OFB",type:"stream"},"aes-128-ctr":{cipher:"AES",key:128,iv:16,mode:"CTR",type:"stream"},"aes-192-ctr":{cipher:"AES",key:192,iv:16,mode:"CTR",type:"stream"},"aes-256-ctr":{cipher:"AES",key:256,iv:16,mode:"CTR",type:"stream"},"aes-128-gcm":{cipher:"AES",key:128,iv:12,mode:"GCM",type:"auth"},"aes-192-gcm":{cipher:"AES",key:192,iv:12,mode:"GCM",type:"auth"},"aes-256-gcm":{cipher:"AES",key:256,iv:12,mode:"GCM",type:"auth"}}},"8E9s":function(e,t){},"8YCc":function(e,t){e.exports={"2.16.840.1.101.3.4.1.1":"aes-128-ecb","2.16.840.1.101.3.4.1.2":"aes-128-cbc","2.16.840.1.101.3.4.1.3":"aes-128-ofb","2.16.840.1.101.3.4.1.4":"aes-128-cfb","2.16.840.1.101.3.4.1.21":"aes-192-ecb","2.16.840.1.101.3.4.1.22":"aes-192-cbc","2.16.840.1.101.3.4.1.23":"aes-192-ofb","2.16.840.1.101.3.4.1.24":"aes-192-cfb","2.16.840.1.101.3.4.1.41":"aes-256-ecb","2.16.840.1.101.3.4.1.42":"aes-256-cbc","2.16.840.1.101.3.4.1.43":"aes-256-ofb","2.16.840.1.101.3.4.1.44":"aes-256-cfb"}},B7WQ:function(e,t,a){"use strict";Object.defineProperty(t,"__esModule",{value:!0});var n=a("Xxa5"),s=a.n(n),r=a("exGp"),c=a.n(r),i=a("PJh5"),f=a.n(i),o=a("bhgC"),d=a("XniH"),u=a("Au9i"),b={data:function(){return{sum:1,page:1,count:10,userid:"",visible:!1,result:[]}},computed:{hasActions:function(){return this.result.length>0}},methods:{nextPage:function(e){var t=this;return c()(s.a.mark(function e(){return s.a.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:if(!(t.page<t.sum)){e.next=4;break}return t.count++,e.next=4,t.queryAccount(void 0);case 4:case"end":return e.stop()}},e,t)}))()},previousPage:function(e){var t=this;return c()(s.a.mark(function e(){return s.a.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:if(!(t.page>1)){e.next=4;break}return t.count--,e.next=4,t.queryAccount(void 0);case 4:case"end":return e.stop()}},e,t)}))()},formatActions:function(e){for(var t=[],a=0;a<e.length;a++){var n=e[a],s=f.a.unix(n.timestamp).format("YYYY-MM-DD HH:mm:ss");switch(n.reason){case 1:t.push("* "+s+" 您发放了红包"+n.ref_lucky_money_id+", 花费 "+n.locked+" "+n.symbol);break;case 2:t.push("* "+s+" 系统为您充值了 "+n.balance+" "+n.symbol+"，请注意查收");break;case 3:t.push("* "+s+" 您领取了 "+n.ref_user_name+"("+n.ref_user_id+") 发放的红包"+n.ref_lucky_money_id+", 获得 "+n.balance+" "+n.symbol);break;case 4:t.push("* "+s+" 您创建的红包"+n.ref_lucky_money_id+"已过期, 退还剩余金额 "+n.locked+" "+n.symbol);break;case 5:t.push("* "+s+" 您充值 "+n.balance+" "+n.symbol+" 已确认, 区块高度: "+n.ref_block_height+", TxID: "+n.ref_tx_id);break;case 6:t.push("* "+s+' "您申请提现 '+n.locked+" "+n.symbol+" 到"+n.name+"地址 "+n.ref_address+" 正在转账中, 手续费 "+n.fee+" "+n.symbol);break;case 7:t.push("* "+s+' "您申请提现 '+Math.abs(n.locked)+" "+n.symbol+" 到"+n.name+"地址 "+n.ref_address+" 转账失败。资金已退还，请查收");break;case 8:t.push("* "+s+' "您申请提现 '+Math.abs(n.locked)+" "+n.symbol+" 到"+n.name+"地址 "+n.ref_address+" 已经转账, TxID："+n.ref_tx_id)}}return t},queryAccount:function(e){var t=this;return c()(s.a.mark(function e(){var a;return s.a.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:if(t.visible=!1,o.a.validateTelegramID(t.userid)){e.next=4;break}return Object(u.Toast)({message:"Telegram ID 输入有误",position:"top"}),e.abrupt("return");case 4:return u.Indicator.open(),e.next=7,d.a.getActions(t.userid,(t.page-1)*t.count,t.count);case 7:if(a=e.sent,u.Indicator.close(),a.ok){e.next=12;break}return Object(u.Toast)({message:"获取用户操作记录失败，请重试",position:"top"}),e.abrupt("return");case 12:t.sum=parseInt(a.data.sum/t.count),0===t.sum&&a.data.sum>0&&(t.sum=1),t.visible=!0,t.result=t.formatActions(a.data.actions);case 16:case"end":return e.stop()}},e,t)}))()}}},p={render:function(){var e=this,t=e.$createElement,a=e._self._c||t;return a("div",[a("div",{staticClass:"page-title"},[e._v("操作记录")]),e._v(" "),a("mt-field",{staticClass:"field",attrs:{label:"Telegram ID",value:e.userid,attr:{maxlength:10}},model:{value:e.userid,callback:function(t){e.userid=t},expression:"userid"}},[a("mt-button",{attrs:{type:"default",size:"small"},nativeOn:{click:function(t){return e.queryAccount(t)}}},[e._v("查询")])],1),e._v(" "),e.visible?a("div",[e.hasActions?a("div",[a("div",e._l(e.result,function(e,t){return a("mt-cell",{key:t,attrs:{title:e}})})),e._v(" "),a("div",{staticClass:"button-group",attrs:{align:"center"}},[a("mt-button",{attrs:{size:"small"},nativeOn:{click:function(t){return e.previousPage(t)}}},[e._v("上一页")]),e._v("\n        　"+e._s(e.page)+"/"+e._s(e.sum)+"　\n        "),a("mt-button",{attrs:{size:"small"},nativeOn:{click:function(t){return e.nextPage(t)}}},[e._v("下一页")])],1)]):a("p",[e._v("没有找到该用户的操作记录")])]):e._e()],1)},staticRenderFns:[]};var l=a("VU/8")(b,p,!1,function(e){a("ha09")},"data-v-052594f8",null);t.default=l.exports},FdXI:function(e,t,a){"use strict";(function(e){var n=a("Xxa5"),s=a.n(n),r=a("d7EF"),c=a.n(r),i=a("mvHQ"),f=a.n(i),o=a("//Fk"),d=a.n(o),u=a("exGp"),b=a.n(u),p=a("Zrlr"),l=a.n(p),m=a("wxAW"),h=a.n(m),v=a("mtWM"),g=a.n(v),k=a("Onw6"),y=a.n(k),j=a("VI/i"),x=a.n(j),w=a("IcnI"),_=a("ktWJ"),A=a("01m3"),S=function(){function t(){l()(this,t)}return h()(t,[{key:"post",value:function(){var e=b()(s.a.mark(function e(t,a){var n,r,i,o,u,b,p,l,m,h;return s.a.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:if(n=Math.floor(Date.now()/1e3),"auth"===t){e.next=6;break}if(r=w.a.getters["network/expire_at"],!(isNaN(r)||n>=r)){e.next=6;break}return w.a.dispatch("network/setSession",void 0),e.abrupt("return",d.a.reject(new _.a));case 6:return i=this.genKey(),o=w.a.getters["network/server"],u=this.encrypt(i,i,f()(a)),b={data:u[0],checksum:u[1],session:w.a.getters["network/session"]},p=void 0,e.prev=11,e.next=14,g.a.post(o+"/admin/"+t,f()(b),{headers:{"Content-Type":"application/x-www-form-urlencoded"},validateStatus:function(e){return!0}});case 14:p=e.sent,e.next=20;break;case 17:return e.prev=17,e.t0=e.catch(11),e.abrupt("return",d.a.reject(new Error(e.t0)));case 20:if(p.data.ack){e.next=23;break}return w.a.dispatch("network/setSession",void 0),e.abrupt("return",d.a.reject(new _.a));case 23:if(w.a.dispatch("network/setExpireAt",5*n*60),l=void 0,void 0,m=this.decrypt(i,i,p.data.data),h=c()(m,2),l=h[0],h[1]===p.data.checksum){e.next=31;break}return e.abrupt("return",d.a.reject(new A.a));case 31:if(l.ok){e.next=33;break}return e.abrupt("return",d.a.reject(new Error("Error POST "+t+" : "+l.error)));case 33:return e.abrupt("return",l.result);case 34:case"end":return e.stop()}},e,this,[[11,17]])}));return function(t,a){return e.apply(this,arguments)}}()},{key:"download",value:function(){var e=b()(s.a.mark(function e(t,a){var n,r,c,i,o,u,b;return s.a.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:if(n=Math.floor(Date.now()/1e3),r=w.a.getters["network/expire_at"],!(isNaN(r)||n>=r)){e.next=5;break}return w.a.dispatch("network/setSession",void 0),e.abrupt("return",d.a.reject(new _.a));case 5:return c=this.genKey(),i=w.a.getters["network/server"],o=this.encrypt(c,c,f()(a)),u={data:o[0],checksum:o[1],session:w.a.getters["network/session"]},b=void 0,e.prev=10,e.next=13,g.a.post(i+"/admin/"+t,f()(u),{headers:{"Content-Type":"application/x-www-form-urlencoded"},responseType:"blob",validateStatus:function(e){return!0}});case 13:b=e.sent,e.next=19;break;case 16:return e.prev=16,e.t0=e.catch(10),e.abrupt("return",d.a.reject(new Error(e.t0)));case 19:if(401!==b.status){e.next=24;break}return w.a.dispatch("network/setSession",void 0),e.abrupt("return",d.a.reject(new _.a));case 24:if(200===b.status){e.next=26;break}return e.abrupt("return",d.a.reject(new Error("Error POST "+t+" : status "+b.status)));case 26:return w.a.dispatch("network/setExpireAt",5*n*60),e.abrupt("return",b.data);case 28:case"end":return e.stop()}},e,this,[[10,16]])}));return function(t,a){return e.apply(this,arguments)}}()},{key:"genKey",value:function(){for(var e=[],t=w.a.getters["network/code"],a=0;a<16;a++)a<t.length?e.push(t[a]):e.push("0");return e.join("")}},{key:"encrypt",value:function(t,a,n){var s=x.a.createCipheriv("aes-128-cbc",t,a),r=s.update(n,"utf8","binary");r+=s.final("binary");var c=y.a.bstr(r)>>>0;return[r=e.from(r,"binary").toString("base64"),c]}},{key:"decrypt",value:function(t,a,n){n=e.from(n,"base64").toString("binary");var s=y.a.bstr(n)>>>0,r=x.a.createDecipheriv("aes-128-cbc",t,a),c=r.update(n,"binary","utf8");return c+=r.final("utf8"),[JSON.parse(c),s]}}]),t}();t.a=new S}).call(t,a("EuP9").Buffer)},G8R2:function(e,t){e.exports=[{path:"/Account",name:"Account",title:"账户信息",icon:"toast"},{path:"/Luckylist",name:"Luckylist",title:"红包列表",icon:"checklist"},{path:"/Actions",name:"Actions",title:"操作记录",icon:"action-sheet"},{path:"/Publish",name:"Publish",title:"发布公告",icon:"message-box"},{path:"/Statistics",name:"Statistics",title:"数据统计",icon:"tabbar"}]},IcnI:function(e,t,a){"use strict";var n=a("7+uW"),s=a("NYxO"),r={state:{server:"",expire_at:0,code:void 0,session:void 0},namespaced:!0,mutations:{setCode:function(e,t){e.code=t,localStorage.setItem("network/code",t)},setServer:function(e,t){e.server=t},setSession:function(e,t){e.session=t,localStorage.setItem("network/session",t)},setExpireAt:function(e,t){e.expire_at=t,localStorage.setItem("network/expire_at",t)}},actions:{setCode:function(e,t){(0,e.commit)("setCode",t)},setServer:function(e,t){(0,e.commit)("setServer",t)},setSession:function(e,t){(0,e.commit)("setSession",t)},setExpireAt:function(e,t){(0,e.commit)("setExpireAt",t)}},getters:{code:function(e){return e.code},server:function(e){return e.server},session:function(e){return e.session},expire_at:function(e){return e.expire_at}}};n.default.use(s.a);t.a=new s.a.Store({modules:{network:r},strict:!0})},KYqO:function(e,t){e.exports={_args:[["elliptic@^6.0.0","/mnt/f/Work/Share/luckybot-management/node_modules/browserify-sign"]],_from:"elliptic@>=6.0.0 <7.0.0",_id:"elliptic@6.4.1",_inCache:!0,_installable:!0,_location:"/elliptic",_nodeVersion:"10.5.0",_npmOperationalInternal:{host:"s3://npm-registry-packages",tmp:"tmp/elliptic_6.4.1_1533787091502_0.6309761823717674"},_npmUser:{email:"fedor@indutny.com",name:"indutny"},_npmVersion:"6.3.0",_phantomChildren:{},_requested:{name:"elliptic",raw:"elliptic@^6.0.0",rawSpec:"^6.0.0",scope:null,spec:">=6.0.0 <7.0.0",type:"range"},_requiredBy:["/browserify-sign","/create-ecdh"],_resolved:"https://registry.npmjs.org/elliptic/-/elliptic-6.4.1.tgz",_shasum:"c2d0b7776911b86722c632c3c06c60f2f819939a",_shrinkwrap:null,_spec:"elliptic@^6.0.0",_where:"/mnt/f/Work/Share/luckybot-management/node_modules/browserify-sign",author:{email:"fedor@indutny.com",name:"Fedor Indutny"},bugs:{url:"https://github.com/indutny/elliptic/issues"},dependencies:{"bn.js":"^4.4.0",brorand:"^1.0.1","hash.js":"^1.0.0","hmac-drbg":"^1.0.0",inherits:"^2.0.1","minimalistic-assert":"^1.0.0","minimalistic-crypto-utils":"^1.0.0"},description:"EC cryptography",devDependencies:{brfs:"^1.4.3",coveralls:"^2.11.3",grunt:"^0.4.5","grunt-browserify":"^5.0.0","grunt-cli":"^1.2.0","grunt-contrib-connect":"^1.0.0","grunt-contrib-copy":"^1.0.0","grunt-contrib-uglify":"^1.0.1","grunt-mocha-istanbul":"^3.0.1","grunt-saucelabs":"^8.6.2",istanbul:"^0.4.2",jscs:"^2.9.0",jshint:"^2.6.0",mocha:"^2.1.0"},directories:{},dist:{fileCount:17,integrity:"sha512-BsXLz5sqX8OHcsh7CqBMztyXARmGQ3LWPtGjJi6DiJHq5C/qvi9P3OqgswKSDftbu8+IoI/QDTAm2fFnQ9SZSQ==","npm-signature":"-----BEGIN PGP SIGNATURE-----\r\nVersion: OpenPGP.js v3.0.4\r\nComment: https://openpgpjs.org\r\n\r\nwsFcBAEBCAAQBQJba7vUCRA9TVsSAnZWagAA+gcP/jWaj5GmDZ0YFi/X4g5O\nx+pxu9i3HbP9YqywT7rz3XFXSaytu0LQDeDEbddl523X69tsbKfzHRTcnW8n\n2r0VjPhttRm+0RpEhBwjSIK34VkQA1xIWh2ugOToKXVCFVLM5VFDPGzbiN6x\n/hpL7gj1hoCRVmuhjnqFQ+vPKACKfv1Eq4CsRmu2focmP37kQpWQlweD/z4V\nJF4NxA33Fvp13Fl+9g4sPHyhUVsW9ojVaG3Ijn70pCaGQM18UPlbODkWQ1QX\nAgteOFjkIOtcalJk3B3qsM8GZeHEcAFvt2T73miJkHdCGNmRQS45Ede+gnj0\nlLlZJsCCKUHtTqrlprHo6AgMnBZufmytyozYAHC1/JYniazSBi2yPHtQeniR\nl69BfiRBdD2rNrMPwmCNRkMqrgel5WMGpaD0xdaFAHF1Ru2ZQFKsA7KvPGgp\nA20+LN11cCib67Pg5XDyrZ92T3yXec+6gQ3iq9d9UBZKFGl0P8ebVqq1LrUJ\na6nekwMpRISWnKcqV72XVmQdBmUWHq9VfVLsWJzVIJqtpHmUO7q74ACP3i4W\n0/F1REeI0YEhh3NjeStdDecfjlu7PY0pLQpbk2I3ms+6DO+cAfeDEev5jFBK\nwQabRNhITeT1FVtxZAcApj33fnCdqwaWr1NS00K5ZRqhDTTzPr/O4KRN4CR1\npstU\r\n=UVBB\r\n-----END PGP SIGNATURE-----\r\n",shasum:"c2d0b7776911b86722c632c3c06c60f2f819939a",tarball:"https://registry.npmjs.org/elliptic/-/elliptic-6.4.1.tgz",unpackedSize:118371},files:["lib"],gitHead:"523da1cf71ddcfd607fbdee1858bc2af47f0e700",homepage:"https://github.com/indutny/elliptic",keywords:["Cryptography","EC","Elliptic","curve"],license:"MIT",main:"lib/elliptic.js",maintainers:[{name:"indutny",email:"fedor@indutny.com"}],name:"elliptic",optionalDependencies:{},readme:"ERROR: No README data found!",repository:{type:"git",url:"git+ssh://git@github.com/indutny/elliptic.git"},scripts:{jscs:"jscs benchmarks/*.js lib/*.js lib/**/*.js lib/**/**/*.js test/index.js",jshint:"jscs benchmarks/*.js lib/*.js lib/**/*.js lib/**/**/*.js test/index.js",lint:"npm run jscs && npm run jshint",test:"npm run lint && npm run unit",unit:"istanbul test _mocha --reporter=spec test/index.js",version:"grunt dist && git add dist/"},version:"6.4.1"}},MCrN:function(e,t,a){"use strict";Object.defineProperty(t,"__esModule",{value:!0});var n=a("Xxa5"),s=a.n(n),r=a("exGp"),c=a.n(r),i=a("bhgC"),f=a("XniH"),o=a("Au9i"),d={data:function(){return{userid:"",amount:"0",show:!1,result:[{title:"ID：",value:"0"},{title:"可用金额：",value:"0"},{title:"锁定金额：",value:"0"}]}},methods:{queryAccount:function(e){var t=this;return c()(s.a.mark(function e(){var a;return s.a.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:if(i.a.validateTelegramID(t.userid)){e.next=3;break}return Object(o.Toast)({message:"Telegram ID 输入有误",position:"top"}),e.abrupt("return");case 3:return o.Indicator.open(),e.next=6,f.a.getBalance(parseInt(t.userid));case 6:if(a=e.sent,o.Indicator.close(),a.ok){e.next=12;break}return Object(o.Toast)({message:"获取账号信息失败",position:"top"}),t.show=!1,e.abrupt("return");case 12:t.show=!0,t.result[0].value=t.userid,t.result[1].value=a.data.amount,t.result[2].value=a.data.locked;case 16:case"end":return e.stop()}},e,t)}))()},depositCurrency:function(e){var t=this;return c()(s.a.mark(function e(){var a,n,r;return s.a.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:if(a=parseFloat(t.amount),!(isNaN(a)||a<=0)){e.next=4;break}return Object(o.Toast)({message:"充值金额输入有误",position:"top"}),e.abrupt("return");case 4:return o.Indicator.open(),n=t.result[0].value,e.next=8,f.a.deposit(n,a);case 8:if(r=e.sent,o.Indicator.close(),r.ok){e.next=13;break}return Object(o.Toast)({message:"充值失败，请重试",position:"top"}),e.abrupt("return");case 13:Object(o.Toast)({message:"充值成功",position:"top"}),t.show=!0,t.amount="0",t.queryAccount();case 17:case"end":return e.stop()}},e,t)}))()}}},u={render:function(){var e=this,t=e.$createElement,a=e._self._c||t;return a("div",[a("div",{staticClass:"page-title"},[e._v("账号信息")]),e._v(" "),a("mt-field",{staticClass:"field",attrs:{label:"Telegram ID",value:e.userid,attr:{maxlength:10}},model:{value:e.userid,callback:function(t){e.userid=t},expression:"userid"}},[a("mt-button",{staticClass:"button",attrs:{type:"default",size:"small"},nativeOn:{click:function(t){return e.queryAccount(t)}}},[e._v("查询")])],1),e._v(" "),e.show?a("div",[e._l(e.result,function(e,t){return a("mt-cell",{key:t,attrs:{title:e.title,value:e.value}})}),e._v(" "),a("mt-field",{attrs:{label:"充值金额：",value:e.amount},model:{value:e.amount,callback:function(t){e.amount=t},expression:"amount"}},[a("mt-button",{staticClass:"button",attrs:{type:"default",size:"small"},nativeOn:{click:function(t){return e.depositCurrency(t)}}},[e._v("充值")])],1)],2):e._e()],1)},staticRenderFns:[]};var b=a("VU/8")(d,u,!1,function(e){a("cRpR")},"data-v-574bb173",null);t.default=b.exports},NHnr:function(e,t,a){"use strict";Object.defineProperty(t,"__esModule",{value:!0});var n=a("7+uW"),s=a("Xxa5"),r=a.n(s),c=a("exGp"),i=a.n(c),f=a("XniH"),o={data:function(){return{code:"",tips:!1,message:"",disabled:!1}},props:["popupVisible"],computed:{getSession:function(){return this.$store.getters["network/session"]}},watch:{getSession:function(e){this.$emit("update",!(e&&e.length>0))}},methods:{handleClick:function(e){var t=this;return i()(r.a.mark(function e(){var a,n;return r.a.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:if(t.tips=!1,6===t.code.length){e.next=5;break}return t.tips=!0,t.message="无效验证码",e.abrupt("return");case 5:a=0;case 6:if(!(a<t.code.length)){e.next=14;break}if(!(t.code[a]<"0"||t.code[a]>"9")){e.next=11;break}return t.tips=!0,t.message="无效验证码",e.abrupt("return");case 11:a++,e.next=6;break;case 14:return t.disabled=!0,t.$store.dispatch("network/setCode",t.code),e.next=18,f.a.auth(t.code);case 18:if((n=e.sent).ok){e.next=24;break}return t.tips=!0,t.message="认证失败",t.disabled=!1,e.abrupt("return");case 24:t.disabled=!1,t.$store.dispatch("network/setSession",n.data.session_id);case 26:case"end":return e.stop()}},e,t)}))()}}},d={render:function(){var e=this,t=e.$createElement,a=e._self._c||t;return a("div",[a("mt-popup",{staticClass:"mint-popup",attrs:{"popup-transition":"popup-fade",closeOnClickModal:!1},model:{value:e.popupVisible,callback:function(t){e.popupVisible=t},expression:"popupVisible"}},[a("h1",{attrs:{align:"center"}},[e._v("身份认证")]),e._v(" "),a("p",[e._v("请输入动态验证码:")]),e._v(" "),a("mt-field",{attrs:{value:e.code,attr:{maxlength:6}},model:{value:e.code,callback:function(t){e.code=t},expression:"code"}}),e._v(" "),e.tips?a("p",[a("font",{attrs:{color:"#FF0000"}},[e._v("* "+e._s(e.message))])],1):e._e(),e._v(" "),a("div",{staticClass:"mint-popup-button",attrs:{align:"center"}},[a("mt-button",{attrs:{size:"normal",type:"primary",disabled:e.disabled},nativeOn:{click:function(t){return e.handleClick(t)}}},[e._v("提交")])],1)],1)],1)},staticRenderFns:[]};var u={name:"App",components:{Popup:a("VU/8")(o,d,!1,function(e){a("yLHI")},"data-v-386a72d8",null).exports},data:function(){return{popupVisible:!1}},computed:{visible:function(){return["/"].indexOf(this.$route.path)<0}},methods:{updatePopupVisible:function(e){this.popupVisible=e}},created:function(){var e=this;return i()(r.a.mark(function t(){var a;return r.a.wrap(function(t){for(;;)switch(t.prev=t.next){case 0:a=localStorage.getItem("network/session"),e.popupVisible=!(a&&a.length>0),e.$store.dispatch("network/setServer","http://127.0.0.1:18127"),e.$store.dispatch("network/setCode",localStorage.getItem("network/code")),e.$store.dispatch("network/setSession",localStorage.getItem("network/session")),e.$store.dispatch("network/setExpireAt",localStorage.getItem("network/expire_at"));case 6:case"end":return t.stop()}},t,e)}))()},mounted:function(){var e=this;return i()(r.a.mark(function t(){var a,n;return r.a.wrap(function(t){for(;;)switch(t.prev=t.next){case 0:a=Math.floor(Date.now()/1e3),n=parseInt(localStorage.getItem("network/expire_at")),e.updatePopupVisible(isNaN(n)||n>=a);case 3:case"end":return t.stop()}},t,e)}))()}},b={render:function(){var e=this.$createElement,t=this._self._c||e;return t("div",{attrs:{id:"app"}},[this.visible?t("router-link",{staticClass:"page-back",attrs:{to:"/"}},[t("i",{staticClass:"mintui mintui-back"})]):this._e(),this._v(" "),t("router-view"),this._v(" "),t("popup",{attrs:{popupVisible:this.popupVisible},on:{update:this.updatePopupVisible}})],1)},staticRenderFns:[]};var p=a("VU/8")(u,b,!1,function(e){a("WoHo")},null,null).exports,l=a("IcnI"),m=a("/ocq"),h=a("G8R2"),v=a.n(h),g={name:"HelloWorld",data:function(){return{navs:[],title:"管理后台"}},created:function(){this.navs=v.a},methods:{backup:function(){var e=this;return i()(r.a.mark(function t(){var a,n,s;return r.a.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:return e.next=2,f.a.backup();case 2:(a=e.sent).ok&&(n=window.URL.createObjectURL(new Blob([a.data])),(s=document.createElement("a")).href=n,s.setAttribute("download","backup.db"),document.body.appendChild(s),s.click());case 4:case"end":return e.stop()}},t,e)}))()}}},k={render:function(){var e=this,t=e.$createElement,a=e._self._c||t;return a("section",{staticClass:"page-main"},[a("div",[a("div",{staticClass:"page-title",domProps:{textContent:e._s(e.title)}}),e._v(" "),e._l(e.navs,function(t,n){return a("mt-cell",{key:n,attrs:{to:t.path,"is-link":""}},[a("div",{attrs:{slot:"title"},slot:"title"},[a("i",{class:["indexicon","icon-"+t.icon]}),e._v(" "),a("span",[e._v(e._s(t.title))])])])}),e._v(" "),a("mt-cell",{attrs:{"is-link":""},nativeOn:{click:function(t){return e.backup(t)}}},[a("div",{attrs:{slot:"title"},slot:"title"},[a("i",{class:["indexicon","icon-pull-down"]}),e._v(" "),a("span",[e._v("备份数据")])])])],2)])},staticRenderFns:[]};var y=a("VU/8")(g,k,!1,function(e){a("8E9s")},null,null).exports;n.default.use(m.a);var j=function(e){for(var t=[],n=0;n<e.length;n++){var s=e[n];t.push({name:s.name,path:s.path,component:a("2zpr")("./pages"+s.path).default,meta:{title:s.title||s.name,description:s.description}})}return t}(v.a);j.push({path:"/",name:"HelloWorld",component:y,meta:{title:"管理后台"}});var x=new m.a({routes:j}),w=a("Au9i"),_=a.n(w);a("d8/S"),a("/wAz");n.default.use(_.a),n.default.config.productionTip=!1,new n.default({el:"#app",router:x,store:l.a,components:{App:p},template:"<App/>"}),document.addEventListener("DOMContentLoaded",function(){window.FastClick&&window.FastClick.attach(document.body)},!1);var A=0;x.beforeEach(function(e,t,a){"/"!==e.path&&(A=document.body.scrollTop),document.title=e.meta.title||document.title,a()}),x.afterEach(function(e){"/"!==e.path?document.body.scrollTop=0:n.default.nextTick(function(){document.body.scrollTop=A})})},QDfD:function(e,t){e.exports={"1.3.132.0.10":"secp256k1","1.3.132.0.33":"p224","1.2.840.10045.3.1.1":"p192","1.2.840.10045.3.1.7":"p256","1.3.132.0.34":"p384","1.3.132.0.35":"p521"}},"Sc0+":function(e,t){},TYnV:function(e,t,a){"use strict";Object.defineProperty(t,"__esModule",{value:!0});var n=a("Xxa5"),s=a.n(n),r=a("exGp"),c=a.n(r),i=a("PJh5"),f=a.n(i),o=a("bhgC"),d=a("XniH"),u=a("Au9i"),b={data:function(){return{sum:1,page:1,count:10,userid:"",visible:!0,result:[]}},computed:{hasLuckymoney:function(){return this.result.length>0}},methods:{nextPage:function(e){var t=this;return c()(s.a.mark(function e(){return s.a.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:if(!(t.page<t.sum)){e.next=4;break}return t.count++,e.next=4,t.queryAccount(void 0);case 4:case"end":return e.stop()}},e,t)}))()},previousPage:function(e){var t=this;return c()(s.a.mark(function e(){return s.a.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:if(!(t.page>1)){e.next=4;break}return t.count--,e.next=4,t.queryAccount(void 0);case 4:case"end":return e.stop()}},e,t)}))()},formatLuckymoney:function(e){for(var t=[],a=0;a<e.length;a++){var n="普通红包",s=e[a],r=s.amount;s.lucky?n="随机红包":r=parseFloat(s.amount)*s.number;var c=f.a.unix(s.timestamp).format("YYYY-MM-DD HH:mm:ss");t.push("* "+c+" ["+n+"] ID: "+s.id+", 红包数量: "+s.number+",           领取数量: "+s.count+", 总金额: "+r+" "+s.asset+", 领取金额: "+s.received+" "+s.asset)}return t},queryAccount:function(e){var t=this;return c()(s.a.mark(function e(){var a;return s.a.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:if(t.visible=!1,o.a.validateTelegramID(t.userid)){e.next=4;break}return Object(u.Toast)({message:"Telegram ID 输入有误",position:"top"}),e.abrupt("return");case 4:return u.Indicator.open(),e.next=7,d.a.getLuckymoney(t.userid,(t.page-1)*t.count,t.count);case 7:if(a=e.sent,u.Indicator.close(),a.ok){e.next=12;break}return Object(u.Toast)({message:"获取用户操作记录失败，请重试",position:"top"}),e.abrupt("return");case 12:t.sum=parseInt(a.data.sum/t.count),0===t.sum&&a.data.sum>0&&(t.sum=1),t.visible=!0,t.result=t.formatLuckymoney(a.data.result);case 16:case"end":return e.stop()}},e,t)}))()}}},p={render:function(){var e=this,t=e.$createElement,a=e._self._c||t;return a("div",[a("div",{staticClass:"page-title"},[e._v("红包列表")]),e._v(" "),a("mt-field",{staticClass:"field",attrs:{label:"Telegram ID",value:e.userid,attr:{maxlength:10}},model:{value:e.userid,callback:function(t){e.userid=t},expression:"userid"}},[a("mt-button",{attrs:{type:"default",size:"small"},nativeOn:{click:function(t){return e.queryAccount(t)}}},[e._v("查询")])],1),e._v(" "),e.visible?a("div",[e.hasLuckymoney?a("div",[a("div",e._l(e.result,function(e,t){return a("mt-cell",{key:t,attrs:{title:e}})})),e._v(" "),a("div",{staticClass:"button-group",attrs:{align:"center"}},[a("mt-button",{attrs:{size:"small"},nativeOn:{click:function(t){return e.previousPage(t)}}},[e._v("上一页")]),e._v("\n        　"+e._s(e.page)+"/"+e._s(e.sum)+"　\n        "),a("mt-button",{attrs:{size:"small"},nativeOn:{click:function(t){return e.nextPage(t)}}},[e._v("下一页")])],1)]):a("p",[e._v("没有找到该用户的操作记录")])]):e._e()],1)},staticRenderFns:[]};var l=a("VU/8")(b,p,!1,function(e){a("Sc0+")},"data-v-753c2730",null);t.default=l.exports},WoHo:function(e,t){},XniH:function(e,t,a){"use strict";var n=a("Xxa5"),s=a.n(n),r=a("exGp"),c=a.n(r),i=a("Zrlr"),f=a.n(i),o=a("wxAW"),d=a.n(o),u=a("FdXI"),b=function(){function e(){f()(this,e)}return d()(e,[{key:"auth",value:function(){var e=c()(s.a.mark(function e(t){var a;return s.a.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:return e.prev=0,e.next=3,u.a.post("auth",{code:t,tonce:Math.floor(Date.now()/1e3)});case 3:return a=e.sent,e.abrupt("return",{ok:!0,data:a});case 7:return e.prev=7,e.t0=e.catch(0),e.abrupt("return",{ok:!1,error:e.t0.message});case 10:case"end":return e.stop()}},e,this,[[0,7]])}));return function(t){return e.apply(this,arguments)}}()},{key:"backup",value:function(){var e=c()(s.a.mark(function e(){var t;return s.a.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:return e.prev=0,e.next=3,u.a.download("backup",{tonce:Math.floor(Date.now()/1e3)});case 3:return t=e.sent,e.abrupt("return",{ok:!0,data:t});case 7:return e.prev=7,e.t0=e.catch(0),e.abrupt("return",{ok:!1,error:e.t0.message});case 10:case"end":return e.stop()}},e,this,[[0,7]])}));return function(){return e.apply(this,arguments)}}()},{key:"deposit",value:function(){var e=c()(s.a.mark(function e(t,a){var n;return s.a.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:return e.prev=0,e.next=3,u.a.post("deposit",{user_id:parseInt(t),amount:a.toString(),tonce:Math.floor(Date.now()/1e3)});case 3:return n=e.sent,e.abrupt("return",{ok:!0,data:n});case 7:return e.prev=7,e.t0=e.catch(0),e.abrupt("return",{ok:!1,error:e.t0.message});case 10:case"end":return e.stop()}},e,this,[[0,7]])}));return function(t,a){return e.apply(this,arguments)}}()},{key:"getBalance",value:function(){var e=c()(s.a.mark(function e(t){var a;return s.a.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:return e.prev=0,e.next=3,u.a.post("balance",{user_id:t,tonce:Math.floor(Date.now()/1e3)});case 3:return a=e.sent,e.abrupt("return",{ok:!0,data:a});case 7:return e.prev=7,e.t0=e.catch(0),e.abrupt("return",{ok:!1,error:e.t0.message});case 10:case"end":return e.stop()}},e,this,[[0,7]])}));return function(t){return e.apply(this,arguments)}}()},{key:"getActions",value:function(){var e=c()(s.a.mark(function e(t,a,n){var r;return s.a.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:return e.prev=0,e.next=3,u.a.post("getactions",{user_id:parseInt(t),offset:parseInt(a),limit:parseInt(n),tonce:Math.floor(Date.now()/1e3)});case 3:return r=e.sent,e.abrupt("return",{ok:!0,data:r});case 7:return e.prev=7,e.t0=e.catch(0),e.abrupt("return",{ok:!1,error:e.t0.message});case 10:case"end":return e.stop()}},e,this,[[0,7]])}));return function(t,a,n){return e.apply(this,arguments)}}()},{key:"getLuckymoney",value:function(){var e=c()(s.a.mark(function e(t,a,n){var r;return s.a.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:return e.prev=0,e.next=3,u.a.post("getluckymoney",{user_id:parseInt(t),offset:parseInt(a),limit:parseInt(n),tonce:Math.floor(Date.now()/1e3)});case 3:return r=e.sent,e.abrupt("return",{ok:!0,data:r});case 7:return e.prev=7,e.t0=e.catch(0),e.abrupt("return",{ok:!1,error:e.t0.message});case 10:case"end":return e.stop()}},e,this,[[0,7]])}));return function(t,a,n){return e.apply(this,arguments)}}()},{key:"publicNotice",value:function(){var e=c()(s.a.mark(function e(t){var a;return s.a.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:return e.prev=0,e.next=3,u.a.post("broadcast",{message:t.toString(),tonce:Math.floor(Date.now()/1e3)});case 3:return a=e.sent,e.abrupt("return",{ok:!0,data:a});case 7:return e.prev=7,e.t0=e.catch(0),e.abrupt("return",{ok:!1,error:e.t0.message});case 10:case"end":return e.stop()}},e,this,[[0,7]])}));return function(t){return e.apply(this,arguments)}}()}]),e}();t.a=new b},b8fy:function(e,t){},bhgC:function(e,t,a){"use strict";t.a={validateTelegramID:function(e){if(e=parseInt(e),isNaN(e))return!1;if(0===(e=e.toString()).length||e.length>10)return!1;for(var t=0;t<e.length;t++)if(e[t]<"0"||e[t]>"9")return!1;return!0}}},cRpR:function(e,t){},"d8/S":function(e,t){},ejIc:function(e,t){e.exports={sha224WithRSAEncryption:{sign:"rsa",hash:"sha224",id:"302d300d06096086480165030402040500041c"},"RSA-SHA224":{sign:"ecdsa/rsa",hash:"sha224",id:"302d300d06096086480165030402040500041c"},sha256WithRSAEncryption:{sign:"rsa",hash:"sha256",id:"3031300d060960864801650304020105000420"},"RSA-SHA256":{sign:"ecdsa/rsa",hash:"sha256",id:"3031300d060960864801650304020105000420"},sha384WithRSAEncryption:{sign:"rsa",hash:"sha384",id:"3041300d060960864801650304020205000430"},"RSA-SHA384":{sign:"ecdsa/rsa",hash:"sha384",id:"3041300d060960864801650304020205000430"},sha512WithRSAEncryption:{sign:"rsa",hash:"sha512",id:"3051300d060960864801650304020305000440"},"RSA-SHA512":{sign:"ecdsa/rsa",hash:"sha512",id:"3051300d060960864801650304020305000440"},"RSA-SHA1":{sign:"rsa",hash:"sha1",id:"3021300906052b0e03021a05000414"},"ecdsa-with-SHA1":{sign:"ecdsa",hash:"sha1",id:""},sha256:{sign:"ecdsa",hash:"sha256",id:""},sha224:{sign:"ecdsa",hash:"sha224",id:""},sha384:{sign:"ecdsa",hash:"sha384",id:""},sha512:{sign:"ecdsa",hash:"sha512",id:""},"DSA-SHA":{sign:"dsa",hash:"sha1",id:""},"DSA-SHA1":{sign:"dsa",hash:"sha1",id:""},DSA:{sign:"dsa",hash:"sha1",id:""},"DSA-WITH-SHA224":{sign:"dsa",hash:"sha224",id:""},"DSA-SHA224":{sign:"dsa",hash:"sha224",id:""},"DSA-WITH-SHA256":{sign:"dsa",hash:"sha256",id:""},"DSA-SHA256":{sign:"dsa",hash:"sha256",id:""},"DSA-WITH-SHA384":{sign:"dsa",hash:"sha384",id:""},"DSA-SHA384":{sign:"dsa",hash:"sha384",id:""},"DSA-WITH-SHA512":{sign:"dsa",hash:"sha512",id:""},"DSA-SHA512":{sign:"dsa",hash:"sha512",id:""},"DSA-RIPEMD160":{sign:"dsa",hash:"rmd160",id:""},ripemd160WithRSA:{sign:"rsa",hash:"rmd160",id:"3021300906052b2403020105000414"},"RSA-RIPEMD160":{sign:"rsa",hash:"rmd160",id:"3021300906052b2403020105000414"},md5WithRSAEncryption:{sign:"rsa",hash:"md5",id:"3020300c06082a864886f70d020505000410"},"RSA-MD5":{sign:"rsa",hash:"md5",id:"3020300c06082a864886f70d020505000410"}}},ha09:function(e,t){},ktWJ:function(e,t,a){"use strict";var n=a("Zx67"),s=a.n(n),r=a("Zrlr"),c=a.n(r),i=a("zwoO"),f=a.n(i),o=a("Pf15"),d=a.n(o),u=function(e){function t(){c()(this,t);var e=f()(this,(t.__proto__||s()(t)).call(this));return e.name="AuthError",e.message="Authentication failed",e}return d()(t,e),t}(Error);t.a=u},oQD1:function(e,t,a){"use strict";Object.defineProperty(t,"__esModule",{value:!0});var n=a("Xxa5"),s=a.n(n),r=a("exGp"),c=a.n(r),i=a("XniH"),f=a("Au9i"),o={data:function(){return{message:""}},methods:{publishNotice:function(e){var t=this;return c()(s.a.mark(function e(){var a;return s.a.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:if(!(0===t.message.length||t.message.length>256)){e.next=3;break}return Object(f.Toast)({message:"消息长度必须大于0并且小于256字节",position:"top"}),e.abrupt("return");case 3:return f.Indicator.open(),e.next=6,i.a.publicNotice(t.message);case 6:if(a=e.sent,f.Indicator.close(),a.ok){e.next=11;break}return Object(f.Toast)({message:"发布公告失败，请重试",position:"top"}),e.abrupt("return");case 11:Object(f.Toast)({message:"发布公告成功",position:"top"});case 12:case"end":return e.stop()}},e,t)}))()}}},d={render:function(){var e=this,t=e.$createElement,a=e._self._c||t;return a("div",[a("div",{staticClass:"page-title"},[e._v("发布公告")]),e._v(" "),a("mt-field",{attrs:{label:"公告内容",type:"textarea",rows:"4",value:e.message},model:{value:e.message,callback:function(t){e.message=t},expression:"message"}}),e._v(" "),a("div",{attrs:{align:"center"}},[a("mt-button",{staticClass:"button",attrs:{type:"primary"},nativeOn:{click:function(t){return e.publishNotice(t)}}},[e._v("发布")])],1)],1)},staticRenderFns:[]};var u=a("VU/8")(o,d,!1,function(e){a("b8fy")},"data-v-77792c88",null);t.default=u.exports},uslO:function(e,t,a){var n={"./af":"3CJN","./af.js":"3CJN","./ar":"3MVc","./ar-dz":"tkWw","./ar-dz.js":"tkWw","./ar-kw":"j8cJ","./ar-kw.js":"j8cJ","./ar-ly":"wPpW","./ar-ly.js":"wPpW","./ar-ma":"dURR","./ar-ma.js":"dURR","./ar-sa":"7OnE","./ar-sa.js":"7OnE","./ar-tn":"BEem","./ar-tn.js":"BEem","./ar.js":"3MVc","./az":"eHwN","./az.js":"eHwN","./be":"3hfc","./be.js":"3hfc","./bg":"lOED","./bg.js":"lOED","./bm":"hng5","./bm.js":"hng5","./bn":"aM0x","./bn.js":"aM0x","./bo":"w2Hs","./bo.js":"w2Hs","./br":"OSsP","./br.js":"OSsP","./bs":"aqvp","./bs.js":"aqvp","./ca":"wIgY","./ca.js":"wIgY","./cs":"ssxj","./cs.js":"ssxj","./cv":"N3vo","./cv.js":"N3vo","./cy":"ZFGz","./cy.js":"ZFGz","./da":"YBA/","./da.js":"YBA/","./de":"DOkx","./de-at":"8v14","./de-at.js":"8v14","./de-ch":"Frex","./de-ch.js":"Frex","./de.js":"DOkx","./dv":"rIuo","./dv.js":"rIuo","./el":"CFqe","./el.js":"CFqe","./en-au":"Sjoy","./en-au.js":"Sjoy","./en-ca":"Tqun","./en-ca.js":"Tqun","./en-gb":"hPuz","./en-gb.js":"hPuz","./en-ie":"ALEw","./en-ie.js":"ALEw","./en-il":"QZk1","./en-il.js":"QZk1","./en-nz":"dyB6","./en-nz.js":"dyB6","./eo":"Nd3h","./eo.js":"Nd3h","./es":"LT9G","./es-do":"7MHZ","./es-do.js":"7MHZ","./es-us":"INcR","./es-us.js":"INcR","./es.js":"LT9G","./et":"XlWM","./et.js":"XlWM","./eu":"sqLM","./eu.js":"sqLM","./fa":"2pmY","./fa.js":"2pmY","./fi":"nS2h","./fi.js":"nS2h","./fo":"OVPi","./fo.js":"OVPi","./fr":"tzHd","./fr-ca":"bXQP","./fr-ca.js":"bXQP","./fr-ch":"VK9h","./fr-ch.js":"VK9h","./fr.js":"tzHd","./fy":"g7KF","./fy.js":"g7KF","./gd":"nLOz","./gd.js":"nLOz","./gl":"FuaP","./gl.js":"FuaP","./gom-latn":"+27R","./gom-latn.js":"+27R","./gu":"rtsW","./gu.js":"rtsW","./he":"Nzt2","./he.js":"Nzt2","./hi":"ETHv","./hi.js":"ETHv","./hr":"V4qH","./hr.js":"V4qH","./hu":"xne+","./hu.js":"xne+","./hy-am":"GrS7","./hy-am.js":"GrS7","./id":"yRTJ","./id.js":"yRTJ","./is":"upln","./is.js":"upln","./it":"FKXc","./it.js":"FKXc","./ja":"ORgI","./ja.js":"ORgI","./jv":"JwiF","./jv.js":"JwiF","./ka":"RnJI","./ka.js":"RnJI","./kk":"j+vx","./kk.js":"j+vx","./km":"5j66","./km.js":"5j66","./kn":"gEQe","./kn.js":"gEQe","./ko":"eBB/","./ko.js":"eBB/","./ky":"6cf8","./ky.js":"6cf8","./lb":"z3hR","./lb.js":"z3hR","./lo":"nE8X","./lo.js":"nE8X","./lt":"/6P1","./lt.js":"/6P1","./lv":"jxEH","./lv.js":"jxEH","./me":"svD2","./me.js":"svD2","./mi":"gEU3","./mi.js":"gEU3","./mk":"Ab7C","./mk.js":"Ab7C","./ml":"oo1B","./ml.js":"oo1B","./mn":"CqHt","./mn.js":"CqHt","./mr":"5vPg","./mr.js":"5vPg","./ms":"ooba","./ms-my":"G++c","./ms-my.js":"G++c","./ms.js":"ooba","./mt":"oCzW","./mt.js":"oCzW","./my":"F+2e","./my.js":"F+2e","./nb":"FlzV","./nb.js":"FlzV","./ne":"/mhn","./ne.js":"/mhn","./nl":"3K28","./nl-be":"Bp2f","./nl-be.js":"Bp2f","./nl.js":"3K28","./nn":"C7av","./nn.js":"C7av","./pa-in":"pfs9","./pa-in.js":"pfs9","./pl":"7LV+","./pl.js":"7LV+","./pt":"ZoSI","./pt-br":"AoDM","./pt-br.js":"AoDM","./pt.js":"ZoSI","./ro":"wT5f","./ro.js":"wT5f","./ru":"ulq9","./ru.js":"ulq9","./sd":"fW1y","./sd.js":"fW1y","./se":"5Omq","./se.js":"5Omq","./si":"Lgqo","./si.js":"Lgqo","./sk":"OUMt","./sk.js":"OUMt","./sl":"2s1U","./sl.js":"2s1U","./sq":"V0td","./sq.js":"V0td","./sr":"f4W3","./sr-cyrl":"c1x4","./sr-cyrl.js":"c1x4","./sr.js":"f4W3","./ss":"7Q8x","./ss.js":"7Q8x","./sv":"Fpqq","./sv.js":"Fpqq","./sw":"DSXN","./sw.js":"DSXN","./ta":"+7/x","./ta.js":"+7/x","./te":"Nlnz","./te.js":"Nlnz","./tet":"gUgh","./tet.js":"gUgh","./tg":"5SNd","./tg.js":"5SNd","./th":"XzD+","./th.js":"XzD+","./tl-ph":"3LKG","./tl-ph.js":"3LKG","./tlh":"m7yE","./tlh.js":"m7yE","./tr":"k+5o","./tr.js":"k+5o","./tzl":"iNtv","./tzl.js":"iNtv","./tzm":"FRPF","./tzm-latn":"krPU","./tzm-latn.js":"krPU","./tzm.js":"FRPF","./ug-cn":"To0v","./ug-cn.js":"To0v","./uk":"ntHu","./uk.js":"ntHu","./ur":"uSe8","./ur.js":"uSe8","./uz":"XU1s","./uz-latn":"/bsm","./uz-latn.js":"/bsm","./uz.js":"XU1s","./vi":"0X8Q","./vi.js":"0X8Q","./x-pseudo":"e/KL","./x-pseudo.js":"e/KL","./yo":"YXlc","./yo.js":"YXlc","./zh-cn":"Vz2w","./zh-cn.js":"Vz2w","./zh-hk":"ZUyn","./zh-hk.js":"ZUyn","./zh-tw":"BbgG","./zh-tw.js":"BbgG"};function s(e){return a(r(e))}function r(e){var t=n[e];if(!(t+1))throw new Error("Cannot find module '"+e+"'.");return t}s.keys=function(){return Object.keys(n)},s.resolve=r,e.exports=s,s.id="uslO"},xfme:function(e,t,a){"use strict";Object.defineProperty(t,"__esModule",{value:!0});var n={render:function(){this.$createElement;this._self._c;return this._m(0)},staticRenderFns:[function(){var e=this.$createElement,t=this._self._c||e;return t("div",[t("div",{staticClass:"page-title"},[this._v("数据统计")])])}]},s=a("VU/8")(null,n,!1,null,null,null);t.default=s.exports},yLHI:function(e,t){}},["NHnr"]);
-//# sourceMappingURL=app.35635652c11571c6c940.js.map
\ No newline at end of file
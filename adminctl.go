@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pquerna/otp/totp"
+	"golang.org/x/crypto/ssh/terminal"
+	"luckybot/app/admin/handlers"
+	"luckybot/app/config"
+	"luckybot/app/storage"
+	"luckybot/app/storage/models"
+)
+
+// 最短密码长度
+const minPasswordLen = 8
+
+// 管理员命令用法
+const adminUsage = `usage: luckybot admin <command> [username]
+commands:
+  list                 list admins
+  add <username> <role>
+                       create admin, print TOTP secret
+  role <username> <role>
+                       change role
+  passwd <username>    change password
+  totp <username>      reset TOTP secret
+  disable <username>   disable admin
+  enable <username>    enable admin
+roles: admin, finance, operator, viewer`
+
+// 管理员命令
+func adminCommand(args []string) int {
+	if len(args) == 0 || (args[0] != "list" && len(args) < 2) ||
+		((args[0] == "add" || args[0] == "role") && len(args) < 3) {
+		fmt.Println(adminUsage)
+		return 2
+	}
+	if (args[0] == "add" || args[0] == "role") && !handlers.IsValidRole(args[2]) {
+		fmt.Printf("Invalid role %s, must be one of: %s\n", args[2], strings.Join(handlers.Roles(), ", "))
+		return 2
+	}
+
+	serveCfg := config.GetServe()
+	if err := storage.ConnectTimeout(serveCfg.BolTDBPath, time.Second); err != nil {
+		fmt.Printf("Failed to open database, %v, stop the server first\n", err)
+		return 2
+	}
+	defer storage.Close()
+
+	var err error
+	model := models.AdminModel{}
+	if args[0] != "list" && args[0] != "add" {
+		if _, err = model.Get(args[1]); err != nil {
+			fmt.Printf("Failed to %s admin, %v\n", args[0], err)
+			return 1
+		}
+	}
+	switch args[0] {
+	case "list":
+		err = listAdmins()
+	case "add":
+		err = addAdmin(args[1], args[2])
+	case "passwd":
+		var hash string
+		if hash, err = readPasswordHash(); err == nil {
+			err = model.Update(args[1], func(admin *models.Admin) {
+				admin.PasswordHash = hash
+			})
+		}
+	case "totp":
+		var secret string
+		if secret, err = generateTOTP(args[1]); err == nil {
+			err = model.Update(args[1], func(admin *models.Admin) {
+				admin.TOTPSecret = secret
+			})
+		}
+	case "role":
+		err = model.Update(args[1], func(admin *models.Admin) {
+			admin.Role = args[2]
+		})
+	case "disable", "enable":
+		err = model.Update(args[1], func(admin *models.Admin) {
+			admin.Disabled = args[0] == "disable"
+		})
+	default:
+		fmt.Println(adminUsage)
+		return 2
+	}
+	if err != nil {
+		fmt.Printf("Failed to %s admin, %v\n", args[0], err)
+		return 1
+	}
+	return 0
+}
+
+// 列出管理员
+func listAdmins() error {
+	model := models.AdminModel{}
+	admins, err := model.List()
+	if err != nil {
+		return err
+	}
+	for _, admin := range admins {
+		state := "enabled"
+		if admin.Disabled {
+			state = "disabled"
+		}
+		fmt.Printf("%s\t%s\t%s\t%s\n", admin.Username, admin.Role, state,
+			time.Unix(admin.CreatedAt, 0).Format(time.RFC3339))
+	}
+	return nil
+}
+
+// 添加管理员
+func addAdmin(username, role string) error {
+	hash, err := readPasswordHash()
+	if err != nil {
+		return err
+	}
+	secret, err := generateTOTP(username)
+	if err != nil {
+		return err
+	}
+
+	model := models.AdminModel{}
+	return model.Create(&models.Admin{
+		Username:     username,
+		PasswordHash: hash,
+		TOTPSecret:   secret,
+		Role:         role,
+		CreatedAt:    time.Now().Unix(),
+	})
+}
+
+// 生成两步验证密钥
+func generateTOTP(username string) (string, error) {
+	serveCfg := config.GetServe()
+	issuer := "luckybot"
+	if len(serveCfg.Name) > 0 {
+		issuer = serveCfg.Name
+	}
+	key, err := totp.Generate(totp.GenerateOpts{Issuer: issuer, AccountName: username})
+	if err != nil {
+		return "", err
+	}
+	fmt.Printf("TOTP secret: %s\nTOTP URL: %s\n", key.Secret(), key.URL())
+	return key.Secret(), nil
+}
+
+// 读取密码并计算哈希, 终端输入时需要确认
+func readPasswordHash() (string, error) {
+	var password string
+	fd := int(os.Stdin.Fd())
+	if terminal.IsTerminal(fd) {
+		fmt.Print("Password: ")
+		first, err := terminal.ReadPassword(fd)
+		fmt.Println()
+		if err != nil {
+			return "", err
+		}
+		fmt.Print("Confirm password: ")
+		second, err := terminal.ReadPassword(fd)
+		fmt.Println()
+		if err != nil {
+			return "", err
+		}
+		if string(first) != string(second) {
+			return "", errors.New("passwords do not match")
+		}
+		password = string(first)
+	} else {
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && len(line) == 0 {
+			return "", err
+		}
+		password = strings.TrimRight(line, "\r\n")
+	}
+
+	if len(password) < minPasswordLen {
+		return "", fmt.Errorf("password must be at least %d characters", minPasswordLen)
+	}
+	return handlers.HashPassword(password)
+}
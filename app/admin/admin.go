@@ -13,13 +13,25 @@ var once sync.Once
 func InitRoute(router *mux.Router) {
 	once.Do(func() {
 		handlers.NewAuthenticatorOnce()
-		router.HandleFunc("/admin/backup", handlers.Backup)
-		router.HandleFunc("/admin/deposit", handlers.Deposit)
-		router.HandleFunc("/admin/balance", handlers.GetBalance)
-		router.HandleFunc("/admin/auth", handlers.Authentication)
-		router.HandleFunc("/admin/broadcast", handlers.Broadcast)
-		router.HandleFunc("/admin/getactions", handlers.GetActions)
-		router.HandleFunc("/admin/subscribers", handlers.Subscribers)
-		router.HandleFunc("/admin/getluckymoney", handlers.GetLuckymoney)
+		router.HandleFunc("/admin/login", handlers.Login)
+		router.HandleFunc("/admin/logout", handlers.RequireAuth("", handlers.Logout))
+		router.HandleFunc("/admin/backup", handlers.RequireAuth(handlers.PermBackup, handlers.Backup))
+		router.HandleFunc("/admin/deposit", handlers.RequireAuth(handlers.PermDeposit, handlers.Deposit))
+		router.HandleFunc("/admin/adjust", handlers.RequireAuth(handlers.PermAdjust, handlers.Adjust))
+		router.HandleFunc("/admin/freeze", handlers.RequireAuth(handlers.PermFreeze, handlers.Freeze))
+		router.HandleFunc("/admin/unfreeze", handlers.RequireAuth(handlers.PermFreeze, handlers.Unfreeze))
+		router.HandleFunc("/admin/balance", handlers.RequireAuth(handlers.PermView, handlers.GetBalance))
+		router.HandleFunc("/admin/audit", handlers.RequireAuth(handlers.PermView, handlers.Audit))
+		router.HandleFunc("/admin/auditlog", handlers.RequireAuth(handlers.PermAuditLog, handlers.GetAuditLogs))
+		router.HandleFunc("/admin/broadcast", handlers.RequireAuth(handlers.PermBroadcast, handlers.Broadcast))
+		router.HandleFunc("/admin/getactions", handlers.RequireAuth(handlers.PermView, handlers.GetActions))
+		router.HandleFunc("/admin/subscribers", handlers.RequireAuth(handlers.PermView, handlers.Subscribers))
+		router.HandleFunc("/admin/stats", handlers.RequireAuth(handlers.PermView, handlers.GetStats))
+		router.HandleFunc("/admin/getluckymoney", handlers.RequireAuth(handlers.PermView, handlers.GetLuckymoney))
+		router.HandleFunc("/admin/getwithdrawals", handlers.RequireAuth(handlers.PermView, handlers.GetWithdrawals))
+		router.HandleFunc("/admin/retrywithdrawal",
+			handlers.RequireAuth(handlers.PermRetryWithdraw, handlers.RetryWithdrawal))
+		router.HandleFunc("/admin/refundwithdrawal",
+			handlers.RequireAuth(handlers.PermRefundWithdraw, handlers.RefundWithdrawal))
 	})
 }
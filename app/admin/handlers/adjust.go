@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/zhangpanyi/basebot/logger"
+	"luckybot/app/config"
+	"luckybot/app/fmath"
+	"luckybot/app/logic/handlers/utils"
+	"luckybot/app/logic/pusher"
+	"luckybot/app/storage/models"
+)
+
+// AdjustRequest 调账请求
+type AdjustRequest struct {
+	UserID int64         `json:"user_id"` // 用户ID
+	Symbol string        `json:"symbol"`  // 资产符号
+	Amount *fmath.Amount `json:"amount"`  // 调整金额, 负数表示扣除
+	Reason string        `json:"reason"`  // 调账原因
+	Ticket string        `json:"ticket"`  // 关联工单
+}
+
+// AdjustRespone 调账响应
+type AdjustRespone struct {
+	Amount    *fmath.Amount `json:"amount"`     // 可用余额
+	Locked    *fmath.Amount `json:"locked"`     // 锁定金额
+	VersionID uint64        `json:"version_id"` // 版本ID
+}
+
+// Adjust 人工调账
+func Adjust(w http.ResponseWriter, r *http.Request) {
+	// 解析请求参数
+	var request AdjustRequest
+	if err := readRequest(r, &request); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+	request.Reason = strings.TrimSpace(request.Reason)
+	request.Ticket = strings.TrimSpace(request.Ticket)
+	if request.UserID == 0 || len(request.Reason) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write(makeErrorRespone("user_id and reason are required"))
+		return
+	}
+	if request.Amount == nil || request.Amount.Sign() == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write(makeErrorRespone("amount must not be 0"))
+		return
+	}
+
+	// 检查资产类型
+	serveCfg := config.GetServe()
+	if len(request.Symbol) == 0 {
+		request.Symbol = serveCfg.Assets[0].Symbol
+	}
+	if _, ok := serveCfg.GetAsset(request.Symbol); !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write(makeErrorRespone("invalid symbol"))
+		return
+	}
+
+	// 调整账户余额
+	admin := currentAdmin(r)
+	version := models.Version{RefMemo: &request.Reason, RefAdmin: &admin.Username}
+	if len(request.Ticket) > 0 {
+		version.RefTicket = &request.Ticket
+	}
+	model := models.AccountModel{}
+	account, _, err := model.Adjust(request.UserID, request.Symbol, request.Amount, &version)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if err == models.ErrInsufficientAmount || err == models.ErrNoSuchTypeAccount {
+			status = http.StatusBadRequest
+			err = models.ErrInsufficientAmount
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+	logger.Warnf("Admin adjustment, admin: %s, user_id: %d, symbol: %s, amount: %s, reason: %s, ticket: %s",
+		admin.Username, request.UserID, request.Symbol, request.Amount.Units().String(),
+		request.Reason, request.Ticket)
+
+	respone := AdjustRespone{Amount: account.Amount, Locked: account.Locked, VersionID: version.ID}
+	jsb, err := json.Marshal(respone)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+
+	// 推送调账通知
+	pusher.Post(request.UserID, utils.MakeHistoryMessage(request.UserID, &version), true, nil)
+
+	// 返回余额信息
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(makeRespone(jsb))
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/zhangpanyi/basebot/logger"
+	"luckybot/app/config"
+	"luckybot/app/storage/models"
+)
+
+// AuditRequest 对账请求
+type AuditRequest struct {
+	Tonce int64 `json:"tonce"` // 时间戳
+}
+
+// Audit 账目对账
+func Audit(w http.ResponseWriter, r *http.Request) {
+	// 解析请求参数
+	var request AuditRequest
+	if err := readRequest(r, &request); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+
+	// 执行账目对账
+	serveCfg := config.GetServe()
+	report, err := models.Audit(serveCfg.Precision, int64(serveCfg.Expire))
+	if err != nil {
+		logger.Warnf("Failed to audit, %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+
+	jsb, err := json.Marshal(report)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+
+	// 返回对账报告
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(makeRespone(jsb))
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/zhangpanyi/basebot/logger"
+	"luckybot/app/storage/models"
+)
+
+// GetAuditLogsRequest 获取操作日志请求
+type GetAuditLogsRequest struct {
+	Username string `json:"username"` // 用户名
+	Action   string `json:"action"`   // 操作接口
+	Offset   uint   `json:"offset"`   // 偏移量
+	Limit    uint   `json:"limit"`    // 返回数量
+}
+
+// GetAuditLogsRespone 获取操作日志响应
+type GetAuditLogsRespone struct {
+	Sum   int                     `json:"sum"`   // 日志总量
+	Count int                     `json:"count"` // 返回数量
+	Logs  []*models.AdminAuditLog `json:"logs"`  // 日志列表
+}
+
+// GetAuditLogs 获取管理员操作日志
+func GetAuditLogs(w http.ResponseWriter, r *http.Request) {
+	// 解析请求参数
+	var request GetAuditLogsRequest
+	if err := readRequest(r, &request); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+
+	// 查询操作日志
+	model := models.AdminAuditModel{}
+	filter := models.AdminAuditFilter{Username: request.Username, Action: request.Action}
+	logs, sum, err := model.GetLogs(&filter, request.Offset, request.Limit)
+	if err != nil {
+		logger.Warnf("Failed to query admin audit logs, %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+	respone := GetAuditLogsRespone{Sum: sum, Count: len(logs), Logs: logs}
+	jsb, err := json.Marshal(respone)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+
+	// 返回日志列表
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(makeRespone(jsb))
+}
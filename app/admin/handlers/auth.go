@@ -1,259 +1,403 @@
 package handlers
 
 import (
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
-	"hash/crc32"
-	"io"
+	"errors"
 	"net/http"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/pquerna/otp/totp"
-	"luckybot/app/admin/crypto"
+	"github.com/zhangpanyi/basebot/logger"
+	"golang.org/x/crypto/bcrypt"
 	"luckybot/app/config"
+	"luckybot/app/storage/models"
 )
 
 var once sync.Once
 var authenticator *Authenticator
 
-// Session 会话
-type Session struct {
-	ID        string
-	IP        string
-	Key       string
-	CreatedAt int64
-	ExpiredAt int64
-}
-
-// Ciphertext 密文消息
-type Ciphertext struct {
-	ACK      bool   `json:"ack"`
-	Session  string `json:"session,omitempty"`
-	Data     string `json:"data"`
-	Checksum uint32 `json:"checksum"`
-}
+// 登录限制
+const (
+	maxLoginFailures  = 5                // 最大失败次数
+	loginLockDuration = 15 * time.Minute // 锁定时长
+	totpPeriod        = 30               // 验证码周期(秒)
+)
 
-// Decode 解密消息
-func (msg *Ciphertext) Decode(key [16]byte) ([]byte, bool) {
-	if len(msg.Data) == 0 {
-		return nil, false
-	}
+var (
+	// ErrInvalidCredentials 身份信息错误
+	ErrInvalidCredentials = errors.New("invalid username, password or code")
+	// ErrTooManyAttempts 尝试次数过多
+	ErrTooManyAttempts = errors.New("too many failed attempts, try again later")
+	// ErrInvalidToken 无效令牌
+	ErrInvalidToken = errors.New("invalid token")
+)
 
-	src, err := base64.StdEncoding.DecodeString(msg.Data)
+// HashPassword 计算密码哈希
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
-		return nil, false
-	}
-
-	checksum := crc32.ChecksumIEEE(src)
-	if checksum != msg.Checksum {
-		return nil, false
+		return "", err
 	}
+	return string(hash), nil
+}
 
-	data, err := crypto.AesDecrypt(src, key)
-	if err != nil {
-		return nil, false
-	}
-	return data, true
+// 令牌内容
+type tokenClaims struct {
+	SessionID string `json:"sid"` // 会话ID
+	Username  string `json:"sub"` // 用户名
+	ExpiresAt int64  `json:"exp"` // 过期时间
 }
 
-// Encode 加密消息
-func (msg *Ciphertext) Encode(src []byte, key [16]byte) error {
-	data, err := crypto.AesEncrypt(src, key)
-	if err != nil {
-		return err
-	}
-	msg.Checksum = crc32.ChecksumIEEE(data)
-	msg.Data = base64.StdEncoding.EncodeToString(data)
-	return nil
+// 登录失败记录, 按用户名和IP统计
+type loginFailure struct {
+	count       int
+	lockedUntil time.Time
 }
 
 // Authenticator 验证器
 type Authenticator struct {
-	mutex    sync.Mutex
-	codes    sync.Map
-	sessions map[string]*Session
+	key       []byte
+	ttl       int64
+	dummyHash []byte
+	mutex     sync.Mutex
+	failures  map[string]*loginFailure
 }
 
 // NewAuthenticatorOnce 创建验证器
 func NewAuthenticatorOnce() {
 	once.Do(func() {
+		model := models.AdminModel{}
+		key, err := model.TokenKey()
+		if err != nil {
+			logger.Panic(err)
+		}
+
+		// 用户不存在时也比较密码, 避免通过耗时判断用户是否存在
+		var buf [16]byte
+		_, _ = rand.Read(buf[:])
+		dummyHash, err := bcrypt.GenerateFromPassword([]byte(hex.EncodeToString(buf[:])), bcrypt.DefaultCost)
+		if err != nil {
+			logger.Panic(err)
+		}
+
+		serveCfg := config.GetServe()
 		authenticator = &Authenticator{
-			sessions: make(map[string]*Session),
+			key:       key,
+			ttl:       serveCfg.Admin.SessionTTL,
+			dummyHash: dummyHash,
+			failures:  make(map[string]*loginFailure),
 		}
-		go authenticator.checkExpiredSessions()
+		go authenticator.removeExpiredSessions()
 	})
 }
 
-// 获取密钥
-func (a *Authenticator) getKey(code string) [16]byte {
-	var key [16]byte
-	for i := 0; i < len(key); i++ {
-		if i >= len(code) {
-			key[i] = byte('0')
-			continue
+// 清理过期会话
+func (a *Authenticator) removeExpiredSessions() {
+	ticker := time.NewTicker(time.Minute * 10)
+	for range ticker.C {
+		model := models.AdminModel{}
+		if err := model.RemoveExpiredSessions(time.Now().Unix()); err != nil {
+			logger.Warnf("Failed to remove expired admin sessions, %v", err)
 		}
-		key[i] = byte(code[i])
-	}
-	return key
-}
 
-// 检查Sessions
-func (a *Authenticator) checkExpiredSessions() {
-	ticker := time.NewTicker(time.Second * 1)
-	for range ticker.C {
 		a.mutex.Lock()
-		now := time.Now().Unix()
-		for k, v := range a.sessions {
-			if v.ExpiredAt <= now {
-				delete(a.sessions, k)
+		now := time.Now()
+		for key, failure := range a.failures {
+			if failure.count < maxLoginFailures || failure.lockedUntil.Before(now) {
+				delete(a.failures, key)
 			}
 		}
 		a.mutex.Unlock()
 	}
 }
 
-// Auth 验证身份
-func (a *Authenticator) Auth(ip string, data []byte) (string, bool) {
-	var msg Ciphertext
-	if err := json.Unmarshal(data, &msg); err != nil {
-		return "", false
-	}
+// 登录失败记录键, 避免他人通过错误密码锁定管理员
+func loginKey(username, ip string) string {
+	return username + "@" + ip
+}
 
-	serveCfg := config.GetServe()
-	code, err := totp.GenerateCode(serveCfg.SecretKey, time.Now())
-	if err != nil {
-		return "", false
+// 是否被锁定
+func (a *Authenticator) isLocked(key string) bool {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	failure, ok := a.failures[key]
+	if !ok || failure.count < maxLoginFailures {
+		return false
 	}
+	if time.Now().Before(failure.lockedUntil) {
+		return true
+	}
+	delete(a.failures, key)
+	return false
+}
 
-	key := a.getKey(code)
-	src, ok := msg.Decode(key)
+// 记录登录结果
+func (a *Authenticator) recordLogin(key string, success bool) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if success {
+		delete(a.failures, key)
+		return
+	}
+	failure, ok := a.failures[key]
 	if !ok {
-		return "", false
+		failure = &loginFailure{}
+		a.failures[key] = failure
+	}
+	failure.count++
+	if failure.count >= maxLoginFailures {
+		failure.lockedUntil = time.Now().Add(loginLockDuration)
 	}
+}
 
-	var request AuthRequest
-	if err := json.Unmarshal(src, &request); err != nil {
-		return "", false
+// 验证两步验证码, 同一验证码只能使用一次, 使用记录保存在管理员信息中
+func (a *Authenticator) validateCode(username, secret, code string) (bool, error) {
+	now := time.Now().Unix()
+	for offset := int64(-1); offset <= 1; offset++ {
+		counter := now/totpPeriod + offset
+		expected, err := totp.GenerateCode(secret, time.Unix(counter*totpPeriod, 0))
+		if err != nil {
+			return false, nil
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) != 1 {
+			continue
+		}
+
+		model := models.AdminModel{}
+		if err = model.UseTOTPCounter(username, counter); err != nil {
+			if err == models.ErrCodeUsed {
+				return false, nil
+			}
+			return false, err
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// Login 登录
+func (a *Authenticator) Login(username, password, code, ip string) (string, *models.AdminSession, error) {
+	key := loginKey(username, ip)
+	if a.isLocked(key) {
+		return "", nil, ErrTooManyAttempts
+	}
+
+	// 验证密码
+	model := models.AdminModel{}
+	admin, err := model.Get(username)
+	if err != nil && err != models.ErrAdminNotFound {
+		return "", nil, err
+	}
+	if admin == nil {
+		_ = bcrypt.CompareHashAndPassword(a.dummyHash, []byte(password))
+		a.recordLogin(key, false)
+		return "", nil, ErrInvalidCredentials
+	}
+	if bcrypt.CompareHashAndPassword([]byte(admin.PasswordHash), []byte(password)) != nil || admin.Disabled {
+		a.recordLogin(key, false)
+		return "", nil, ErrInvalidCredentials
+	}
+	valid, err := a.validateCode(username, admin.TOTPSecret, code)
+	if err != nil {
+		return "", nil, err
 	}
+	if !valid {
+		a.recordLogin(key, false)
+		return "", nil, ErrInvalidCredentials
+	}
+	a.recordLogin(key, true)
 
+	// 创建会话
 	var buf [16]byte
-	_, _ = rand.Read(buf[:])
-	session := Session{
+	if _, err = rand.Read(buf[:]); err != nil {
+		return "", nil, err
+	}
+	now := time.Now().Unix()
+	session := models.AdminSession{
 		ID:        hex.EncodeToString(buf[:]),
+		Username:  username,
 		IP:        ip,
-		Key:       code,
-		CreatedAt: time.Now().Unix(),
-		ExpiredAt: time.Now().Add(time.Minute * 5).Unix(),
+		CreatedAt: now,
+		ExpiresAt: now + a.ttl,
+	}
+	if err = model.AddSession(&session); err != nil {
+		return "", nil, err
 	}
 
-	a.mutex.Lock()
-	a.sessions[session.ID] = &session
-	defer a.mutex.Unlock()
-	return session.ID, true
+	token, err := a.sign(&tokenClaims{
+		SessionID: session.ID,
+		Username:  session.Username,
+		ExpiresAt: session.ExpiresAt,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	return token, &session, nil
 }
 
-// KeepAlive 保持活跃
-func (a *Authenticator) KeepAlive(sessionID string) {
-	a.mutex.Lock()
-	defer a.mutex.Unlock()
-	session, ok := a.sessions[sessionID]
-	if !ok {
-		return
+// Verify 验证令牌
+func (a *Authenticator) Verify(token string) (*models.AdminSession, *models.Admin, error) {
+	claims, err := a.parse(token)
+	if err != nil {
+		return nil, nil, err
+	}
+	if claims.ExpiresAt <= time.Now().Unix() {
+		return nil, nil, ErrInvalidToken
 	}
-	session.ExpiredAt += 60 * 5
-}
 
-// Decode 解码消息
-func (a *Authenticator) Decode(data []byte) (string, []byte, bool) {
-	var msg Ciphertext
-	if err := json.Unmarshal(data, &msg); err != nil {
-		return "", nil, false
+	// 检查会话状态
+	model := models.AdminModel{}
+	session, err := model.GetSession(claims.SessionID)
+	if err != nil {
+		if err == models.ErrSessionNotFound {
+			return nil, nil, ErrInvalidToken
+		}
+		return nil, nil, err
+	}
+	if session.Revoked || session.Username != claims.Username || session.ExpiresAt != claims.ExpiresAt {
+		return nil, nil, ErrInvalidToken
 	}
 
-	a.mutex.Lock()
-	session, ok := a.sessions[msg.Session]
-	if !ok {
-		a.mutex.Unlock()
-		return "", nil, false
+	// 检查管理员状态
+	admin, err := model.Get(session.Username)
+	if err != nil {
+		if err == models.ErrAdminNotFound {
+			return nil, nil, ErrInvalidToken
+		}
+		return nil, nil, err
+	}
+	if admin.Disabled {
+		return nil, nil, ErrInvalidToken
 	}
-	a.mutex.Unlock()
+	return session, admin, nil
+}
 
-	jsb, ok := msg.Decode(a.getKey(session.Key))
-	if !ok {
-		return "", nil, false
+// Logout 注销会话
+func (a *Authenticator) Logout(session *models.AdminSession) error {
+	model := models.AdminModel{}
+	return model.RevokeSession(session.ID)
+}
+
+// 生成令牌
+func (a *Authenticator) sign(claims *tokenClaims) (string, error) {
+	jsb, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
 	}
-	return session.ID, jsb, true
+	payload := base64.RawURLEncoding.EncodeToString(jsb)
+	mac := hmac.New(sha256.New, a.key)
+	mac.Write([]byte(payload))
+	return payload + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
 }
 
-// Encode 编码消息
-func (a *Authenticator) Encode(sessionID string, data []byte) []byte {
-	a.mutex.Lock()
-	session, ok := a.sessions[sessionID]
-	if !ok {
-		a.mutex.Unlock()
-		msg := Ciphertext{}
-		msg.ACK = false
-		jsb, _ := json.Marshal(&msg)
-		return jsb
+// 解析令牌
+func (a *Authenticator) parse(token string) (*tokenClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return nil, ErrInvalidToken
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	mac := hmac.New(sha256.New, a.key)
+	mac.Write([]byte(parts[0]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, ErrInvalidToken
 	}
-	a.mutex.Unlock()
 
-	msg := Ciphertext{}
-	msg.ACK = true
-	err := msg.Encode(data, a.getKey(session.Key))
+	jsb, err := base64.RawURLEncoding.DecodeString(parts[0])
 	if err != nil {
-		return nil
+		return nil, ErrInvalidToken
 	}
-	jsb, _ := json.Marshal(&msg)
-	return jsb
+	var claims tokenClaims
+	if err = json.Unmarshal(jsb, &claims); err != nil {
+		return nil, ErrInvalidToken
+	}
+	return &claims, nil
 }
 
-// AuthRequest 身份认证请求
-type AuthRequest struct {
-	Tonce int64 `json:"tonce"` // 时间戳
+// LoginRequest 登录请求
+type LoginRequest struct {
+	Username string `json:"username"` // 用户名
+	Password string `json:"password"` // 密码
+	Code     string `json:"code"`     // 两步验证码
 }
 
-// AuthRespone 身份认证响应
-type AuthRespone struct {
-	SessionID string `json:"session_id"` // 会话ID
+// LoginRespone 登录响应
+type LoginRespone struct {
+	Token     string `json:"token"`      // 会话令牌
+	ExpiresAt int64  `json:"expires_at"` // 过期时间
 }
 
-// Authentication 身份认证
-func Authentication(w http.ResponseWriter, r *http.Request) {
+// Login 管理员登录
+func Login(w http.ResponseWriter, r *http.Request) {
 	// 跨域访问
 	allowAccessControl(w)
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
 
 	// 解析请求参数
-	data, err := io.ReadAll(r.Body)
-	defer r.Body.Close()
-	if err != nil {
-		w.WriteHeader(http.StatusOK)
-		_, _ = w.Write(makeErrorRespone("", ""))
+	var request LoginRequest
+	if err := readRequest(r, &request); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
 		return
 	}
 
-	// 验证数据合法性
-	address := strings.Split(r.RemoteAddr, ":")[0]
-	sessionID, ok := authenticator.Auth(address, data)
-	if !ok {
-		w.WriteHeader(http.StatusOK)
-		_, _ = w.Write(makeErrorRespone("", ""))
+	// 验证身份信息
+	ip := remoteIP(r)
+	token, session, err := authenticator.Login(request.Username, request.Password, request.Code, ip)
+	if err != nil {
+		logger.Warnf("Admin login failed, username: %s, ip: %s, %v", request.Username, ip, err)
+		status := http.StatusInternalServerError
+		switch err {
+		case ErrInvalidCredentials:
+			status = http.StatusUnauthorized
+		case ErrTooManyAttempts:
+			status = http.StatusTooManyRequests
+		}
+		writeAuditLog(r, request.Username, "", nil, status)
+		w.WriteHeader(status)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
 		return
 	}
+	logger.Infof("Admin login success, username: %s, ip: %s", request.Username, ip)
+	writeAuditLog(r, request.Username, "", nil, http.StatusOK)
 
-	respone := AuthRespone{SessionID: sessionID}
+	respone := LoginRespone{Token: token, ExpiresAt: session.ExpiresAt}
 	jsb, err := json.Marshal(&respone)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
-		_, _ = w.Write(makeErrorRespone(sessionID, err.Error()))
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+
+	// 返回结果
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(makeRespone(jsb))
+}
+
+// Logout 管理员注销
+func Logout(w http.ResponseWriter, r *http.Request) {
+	session := currentSession(r)
+	if err := authenticator.Logout(session); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
 		return
 	}
 
 	// 返回结果
 	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write(makeRespone(sessionID, jsb))
+	_, _ = w.Write(makeRespone([]byte("true")))
 }
@@ -2,7 +2,6 @@ package handlers
 
 import (
 	"bytes"
-	"encoding/json"
 	"net/http"
 	"strconv"
 
@@ -16,22 +15,11 @@ type BackupRequest struct {
 
 // Backup 备份数据库
 func Backup(w http.ResponseWriter, r *http.Request) {
-	// 跨域访问
-	allowAccessControl(w)
-
-	// 验证权限
-	sessionID, data, ok := authentication(r)
-	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		_, _ = w.Write(makeErrorRespone("", ""))
-		return
-	}
-
 	// 解析请求参数
 	var request BackupRequest
-	if err := json.Unmarshal(data, &request); err != nil {
+	if err := readRequest(r, &request); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
-		_, _ = w.Write(makeErrorRespone(sessionID, err.Error()))
+		_, _ = w.Write(makeErrorRespone(err.Error()))
 		return
 	}
 
@@ -40,7 +28,7 @@ func Backup(w http.ResponseWriter, r *http.Request) {
 	size, err := storage.Backup(buf)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
-		_, _ = w.Write(makeErrorRespone(sessionID, err.Error()))
+		_, _ = w.Write(makeErrorRespone(err.Error()))
 		return
 	}
 
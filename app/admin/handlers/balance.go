@@ -3,74 +3,85 @@ package handlers
 import (
 	"encoding/json"
 	"errors"
-	"math/big"
 	"net/http"
 
 	"luckybot/app/config"
+	"luckybot/app/fmath"
 	"luckybot/app/storage"
 	"luckybot/app/storage/models"
 )
 
 // GetBalanceRequest 获取余额请求
 type GetBalanceRequest struct {
-	UserID int64 `json:"user_id"` // 用户ID
-	Tonce  int64 `json:"tonce"`   // 时间戳
+	UserID int64  `json:"user_id"` // 用户ID
+	Symbol string `json:"symbol"`  // 资产符号
+	Tonce  int64  `json:"tonce"`   // 时间戳
 }
 
 // GetBalanceRespone 获取余额响应
 type GetBalanceRespone struct {
-	Amount *big.Float `json:"amount"` // 可用余额
-	Locked *big.Float `json:"locked"` // 锁定金额
+	Amount *fmath.Amount `json:"amount"`                  // 可用余额
+	Locked *fmath.Amount `json:"locked"`                  // 锁定金额
+	Frozen bool          `json:"frozen"`                  // 是否冻结
+	Reason string        `json:"frozen_reason,omitempty"` // 冻结原因
 }
 
 // GetBalance 获取余额
 func GetBalance(w http.ResponseWriter, r *http.Request) {
-	// 跨域访问
-	allowAccessControl(w)
-
-	// 验证权限
-	sessionID, data, ok := authentication(r)
-	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		_, _ = w.Write(makeErrorRespone("", ""))
+	// 解析请求参数
+	var request GetBalanceRequest
+	if err := readRequest(r, &request); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
 		return
 	}
 
-	// 解析请求参数
-	var request GetBalanceRequest
-	if err := json.Unmarshal(data, &request); err != nil {
+	// 检查资产类型
+	serveCfg := config.GetServe()
+	if len(request.Symbol) == 0 {
+		request.Symbol = serveCfg.Assets[0].Symbol
+	}
+	if _, ok := serveCfg.GetAsset(request.Symbol); !ok {
 		w.WriteHeader(http.StatusBadRequest)
-		_, _ = w.Write(makeErrorRespone(sessionID, err.Error()))
+		_, _ = w.Write(makeErrorRespone("invalid symbol"))
 		return
 	}
 
 	// 获取账户余额
-	serveCfg := config.GetServe()
 	model := models.AccountModel{}
-	account, err := model.GetAccount(request.UserID, serveCfg.Symbol)
-	if err != nil && !errors.Is(err, storage.ErrNoBucket) {
+	account, err := model.GetAccount(request.UserID, request.Symbol)
+	if err != nil && !errors.Is(err, storage.ErrNoBucket) && !errors.Is(err, models.ErrNoSuchTypeAccount) {
 		w.WriteHeader(http.StatusInternalServerError)
-		_, _ = w.Write(makeErrorRespone(sessionID, err.Error()))
+		_, _ = w.Write(makeErrorRespone(err.Error()))
 		return
 	}
 
-	if account == nil {
+	// 没有此资产账户时余额为零
+	if err != nil || account == nil {
 		account = &models.Account{
-			Amount: big.NewFloat(0),
-			Locked: big.NewFloat(0),
+			Amount: fmath.Zero(),
+			Locked: fmath.Zero(),
 		}
 	}
 
-	respone := GetBalanceRespone{Amount: account.Amount, Locked: account.Locked}
+	// 获取冻结状态
+	frozen, reason, err := model.IsFrozen(request.UserID)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+
+	respone := GetBalanceRespone{Amount: account.Amount, Locked: account.Locked, Frozen: frozen, Reason: reason}
 	jsb, err := json.Marshal(respone)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
-		_, _ = w.Write(makeErrorRespone(sessionID, err.Error()))
+		_, _ = w.Write(makeErrorRespone(err.Error()))
 		return
 	}
 
 	// 返回余额信息
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write(makeRespone(sessionID, jsb))
+	_, _ = w.Write(makeRespone(jsb))
 }
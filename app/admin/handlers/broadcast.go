@@ -21,22 +21,11 @@ type BroadcastRespone struct {
 
 // Broadcast 广播消息
 func Broadcast(w http.ResponseWriter, r *http.Request) {
-	// 跨域访问
-	allowAccessControl(w)
-
-	// 验证权限
-	sessionID, data, ok := authentication(r)
-	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		_, _ = w.Write(makeErrorRespone("", ""))
-		return
-	}
-
 	// 解析请求参数
 	var request BroadcastRequest
-	if err := json.Unmarshal(data, &request); err != nil || len(request.Message) == 0 {
+	if err := readRequest(r, &request); err != nil || len(request.Message) == 0 {
 		w.WriteHeader(http.StatusBadRequest)
-		_, _ = w.Write(makeErrorRespone(sessionID, err.Error()))
+		_, _ = w.Write(makeErrorRespone(err.Error()))
 		return
 	}
 
@@ -46,7 +35,7 @@ func Broadcast(w http.ResponseWriter, r *http.Request) {
 	subscribers, err := model.GetSubscribers()
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
-		_, _ = w.Write(makeErrorRespone(sessionID, err.Error()))
+		_, _ = w.Write(makeErrorRespone(err.Error()))
 		return
 	}
 	for _, userID := range subscribers {
@@ -57,12 +46,12 @@ func Broadcast(w http.ResponseWriter, r *http.Request) {
 	jsb, err = json.Marshal(&respone)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
-		_, _ = w.Write(makeErrorRespone(sessionID, err.Error()))
+		_, _ = w.Write(makeErrorRespone(err.Error()))
 		return
 	}
 
 	// 返回结果
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write(makeRespone(sessionID, jsb))
+	_, _ = w.Write(makeRespone(jsb))
 }
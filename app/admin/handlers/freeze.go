@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/zhangpanyi/basebot/logger"
+	"luckybot/app/config"
+	"luckybot/app/logic/handlers/utils"
+	"luckybot/app/logic/pusher"
+	"luckybot/app/storage/models"
+)
+
+// FreezeRequest 冻结账户请求
+type FreezeRequest struct {
+	UserID int64  `json:"user_id"` // 用户ID
+	Reason string `json:"reason"`  // 冻结原因
+}
+
+// FreezeRespone 冻结账户响应
+type FreezeRespone struct {
+	Frozen bool   `json:"frozen"`                  // 是否冻结
+	Reason string `json:"frozen_reason,omitempty"` // 冻结原因
+}
+
+// Freeze 冻结账户
+func Freeze(w http.ResponseWriter, r *http.Request) {
+	setFrozen(w, r, true)
+}
+
+// Unfreeze 解冻账户
+func Unfreeze(w http.ResponseWriter, r *http.Request) {
+	setFrozen(w, r, false)
+}
+
+// 冻结或解冻账户
+func setFrozen(w http.ResponseWriter, r *http.Request, frozen bool) {
+	// 解析请求参数
+	var request FreezeRequest
+	if err := readRequest(r, &request); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+	request.Reason = strings.TrimSpace(request.Reason)
+	if request.UserID == 0 || len(request.Reason) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write(makeErrorRespone("user_id and reason are required"))
+		return
+	}
+
+	// 更新已有的资产账户
+	serveCfg := config.GetServe()
+	symbols := make([]string, 0, len(serveCfg.Assets))
+	for _, asset := range serveCfg.Assets {
+		symbols = append(symbols, asset.Symbol)
+	}
+	model := models.AccountModel{}
+	version, err := model.SetFrozen(request.UserID, symbols, frozen, request.Reason)
+	if err == models.ErrNoSuchTypeAccount {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write(makeErrorRespone("user has no accounts"))
+		return
+	}
+	if err != nil {
+		logger.Warnf("Failed to set account frozen, user_id: %d, frozen: %v, %v", request.UserID, frozen, err)
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+	logger.Warnf("Set account frozen, admin: %s, user_id: %d, frozen: %v, reason: %s",
+		currentAdmin(r).Username, request.UserID, frozen, request.Reason)
+
+	respone := FreezeRespone{Frozen: frozen}
+	if frozen {
+		respone.Reason = request.Reason
+	}
+	jsb, err := json.Marshal(respone)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+
+	// 推送账户通知
+	pusher.Post(request.UserID, utils.MakeHistoryMessage(request.UserID, version), true, nil)
+
+	// 返回冻结状态
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(makeRespone(jsb))
+}
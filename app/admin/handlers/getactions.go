@@ -25,22 +25,11 @@ type GetActionsRespone struct {
 
 // GetActions 获取用户操作
 func GetActions(w http.ResponseWriter, r *http.Request) {
-	// 跨域访问
-	allowAccessControl(w)
-
-	// 验证权限
-	sessionID, data, ok := authentication(r)
-	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		_, _ = w.Write(makeErrorRespone("", ""))
-		return
-	}
-
 	// 解析请求参数
 	var request GetActionsRequest
-	if err := json.Unmarshal(data, &request); err != nil {
+	if err := readRequest(r, &request); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
-		_, _ = w.Write(makeErrorRespone(sessionID, err.Error()))
+		_, _ = w.Write(makeErrorRespone(err.Error()))
 		return
 	}
 
@@ -50,19 +39,19 @@ func GetActions(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		logger.Warnf("Failed to query user actions, %v", err)
 		w.WriteHeader(http.StatusInternalServerError)
-		_, _ = w.Write(makeErrorRespone(sessionID, err.Error()))
+		_, _ = w.Write(makeErrorRespone(err.Error()))
 		return
 	}
 	respone := GetActionsRespone{Sum: sum, Count: len(actions), Actions: actions}
 	jsb, err := json.Marshal(respone)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
-		_, _ = w.Write(makeErrorRespone(sessionID, err.Error()))
+		_, _ = w.Write(makeErrorRespone(err.Error()))
 		return
 	}
 
 	// 返回余额信息
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write(makeRespone(sessionID, jsb))
+	_, _ = w.Write(makeRespone(jsb))
 }
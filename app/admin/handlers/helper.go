@@ -1,29 +1,166 @@
 package handlers
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"io"
+	"net"
 	"net/http"
+	"strings"
+	"time"
+
+	"github.com/zhangpanyi/basebot/logger"
+	"luckybot/app/storage/models"
+)
+
+// 上下文键
+type contextKey int
+
+const (
+	sessionKey contextKey = iota
+	adminKey
 )
 
-// 身份验证
-func authentication(r *http.Request) (string, []byte, bool) {
-	data, err := io.ReadAll(r.Body)
+// 日志中请求参数的最大长度
+const maxAuditParamsLen = 4096
+
+// 请求体的最大长度
+const maxRequestBodySize = 1 << 20
+
+// 记录响应状态码
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader 写入状态码
+func (recorder *statusRecorder) WriteHeader(status int) {
+	recorder.status = status
+	recorder.ResponseWriter.WriteHeader(status)
+}
+
+// RequireAuth 身份验证中间件, 请求头需携带 Authorization: Bearer <token>
+// 管理员角色必须拥有perm权限, 所有请求都会记录到操作日志, 令牌无效时不记录请求参数
+func RequireAuth(perm Permission, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// 跨域访问
+		allowAccessControl(w)
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		// 验证令牌
+		token := r.Header.Get("Authorization")
+		if !strings.HasPrefix(token, "Bearer ") {
+			writeAuditLog(r, "", "", nil, http.StatusUnauthorized)
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write(makeErrorRespone(ErrInvalidToken.Error()))
+			return
+		}
+		session, admin, err := authenticator.Verify(strings.TrimPrefix(token, "Bearer "))
+		if err != nil {
+			if err != ErrInvalidToken {
+				logger.Warnf("Failed to verify admin token, %v", err)
+			}
+			writeAuditLog(r, "", "", nil, http.StatusUnauthorized)
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write(makeErrorRespone(ErrInvalidToken.Error()))
+			return
+		}
+
+		// 读取请求参数
+		data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestBodySize))
+		r.Body.Close()
+		if err != nil {
+			writeAuditLog(r, admin.Username, admin.Role, nil, http.StatusBadRequest)
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write(makeErrorRespone(err.Error()))
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(data))
+
+		// 检查权限
+		if !HasPermission(admin.Role, perm) {
+			writeAuditLog(r, admin.Username, admin.Role, data, http.StatusForbidden)
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write(makeErrorRespone("permission denied"))
+			return
+		}
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		ctx := context.WithValue(r.Context(), sessionKey, session)
+		ctx = context.WithValue(ctx, adminKey, admin)
+		next(recorder, r.WithContext(ctx))
+		writeAuditLog(r, admin.Username, admin.Role, data, recorder.status)
+	}
+}
+
+// 写入操作日志
+func writeAuditLog(r *http.Request, username, role string, params []byte, status int) {
+	log := models.AdminAuditLog{
+		Username:  username,
+		Role:      role,
+		Action:    r.URL.Path,
+		IP:        remoteIP(r),
+		Status:    status,
+		Timestamp: time.Now().Unix(),
+	}
+	if len(params) > 0 {
+		if len(params) <= maxAuditParamsLen && json.Valid(params) {
+			log.Params = params
+		} else {
+			if len(params) > maxAuditParamsLen {
+				params = params[:maxAuditParamsLen]
+			}
+			log.Params, _ = json.Marshal(string(params))
+		}
+	}
+
+	model := models.AdminAuditModel{}
+	if err := model.Append(&log); err != nil {
+		logger.Errorf("Failed to write admin audit log, username: %s, action: %s, %v",
+			username, log.Action, err)
+	}
+}
+
+// 获取当前会话
+func currentSession(r *http.Request) *models.AdminSession {
+	session, _ := r.Context().Value(sessionKey).(*models.AdminSession)
+	return session
+}
+
+// 获取当前管理员
+func currentAdmin(r *http.Request) *models.Admin {
+	admin, _ := r.Context().Value(adminKey).(*models.Admin)
+	return admin
+}
+
+// 读取请求参数, 空请求体视为空对象
+func readRequest(r *http.Request, request interface{}) error {
+	data, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, maxRequestBodySize))
 	defer r.Body.Close()
 	if err != nil {
-		return "", nil, false
+		return err
 	}
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, request)
+}
 
-	sessionID, src, ok := authenticator.Decode(data)
-	if !ok {
-		return "", nil, false
+// 获取来源IP
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
 	}
-	authenticator.KeepAlive(sessionID)
-	return sessionID, src, true
+	return host
 }
 
 // 生成响应
-func makeRespone(sessionID string, result []byte) []byte {
+func makeRespone(result []byte) []byte {
 	respone := struct {
 		OK     bool            `json:"ok"`
 		Result json.RawMessage `json:"result"`
@@ -31,13 +168,12 @@ func makeRespone(sessionID string, result []byte) []byte {
 		OK:     true,
 		Result: result,
 	}
-	src, _ := json.Marshal(&respone)
-	data := authenticator.Encode(sessionID, src)
+	data, _ := json.Marshal(&respone)
 	return data
 }
 
 // 生成错误响应
-func makeErrorRespone(sessionID, reason string) []byte {
+func makeErrorRespone(reason string) []byte {
 	respone := struct {
 		OK    bool   `json:"ok"`
 		Error string `json:"error"`
@@ -45,8 +181,7 @@ func makeErrorRespone(sessionID, reason string) []byte {
 		OK:    false,
 		Error: reason,
 	}
-	src, _ := json.Marshal(&respone)
-	data := authenticator.Encode(sessionID, src)
+	data, _ := json.Marshal(&respone)
 	return data
 }
 
@@ -56,5 +191,5 @@ func allowAccessControl(w http.ResponseWriter) {
 	header.Add("Content-Type", "application/json")
 	header.Add("Access-Control-Allow-Origin", "*")
 	header.Add("Access-Control-Allow-Methods", "GET,POST")
-	header.Add("Access-Control-Allow-Headers", "Content-Type")
+	header.Add("Access-Control-Allow-Headers", "Authorization,Content-Type")
 }
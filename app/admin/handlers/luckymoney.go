@@ -30,22 +30,11 @@ type GetLuckymoneyRespone struct {
 
 // GetLuckymoney 获取红包信息
 func GetLuckymoney(w http.ResponseWriter, r *http.Request) {
-	// 跨域访问
-	allowAccessControl(w)
-
-	// 验证权限
-	sessionID, data, ok := authentication(r)
-	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		_, _ = w.Write(makeErrorRespone("", ""))
-		return
-	}
-
 	// 解析请求参数
 	var request GetLuckymoneyRequest
-	if err := json.Unmarshal(data, &request); err != nil {
+	if err := readRequest(r, &request); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
-		_, _ = w.Write(makeErrorRespone(sessionID, err.Error()))
+		_, _ = w.Write(makeErrorRespone(err.Error()))
 		return
 	}
 
@@ -54,7 +43,7 @@ func GetLuckymoney(w http.ResponseWriter, r *http.Request) {
 	ids, sum, err := model.Collection(request.UserID, true, uint(request.Offset), uint(request.Limit), true)
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
-		_, _ = w.Write(makeErrorRespone(sessionID, err.Error()))
+		_, _ = w.Write(makeErrorRespone(err.Error()))
 		return
 	}
 
@@ -71,7 +60,7 @@ func GetLuckymoney(w http.ResponseWriter, r *http.Request) {
 	historyIds, historySum, err := model.Collection(request.UserID, false, uint(request.Offset), uint(request.Limit), true)
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
-		_, _ = w.Write(makeErrorRespone(sessionID, err.Error()))
+		_, _ = w.Write(makeErrorRespone(err.Error()))
 		return
 	}
 
@@ -84,7 +73,7 @@ func GetLuckymoney(w http.ResponseWriter, r *http.Request) {
 		data, received, err := model.GetLuckyMoney(idset[i])
 		if err != nil {
 			w.WriteHeader(http.StatusBadRequest)
-			_, _ = w.Write(makeErrorRespone(sessionID, err.Error()))
+			_, _ = w.Write(makeErrorRespone(err.Error()))
 			return
 		}
 		result = append(result, &Luckymoney{LuckyMoney: data, Count: received})
@@ -95,12 +84,12 @@ func GetLuckymoney(w http.ResponseWriter, r *http.Request) {
 	jsb, err := json.Marshal(respone)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
-		_, _ = w.Write(makeErrorRespone(sessionID, err.Error()))
+		_, _ = w.Write(makeErrorRespone(err.Error()))
 		return
 	}
 
 	// 返回余额信息
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write(makeRespone(sessionID, jsb))
+	_, _ = w.Write(makeRespone(jsb))
 }
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"sort"
+)
+
+// Permission 权限
+type Permission string
+
+// 权限列表
+const (
+	PermView           Permission = "view"            // 查询用户和对账数据
+	PermBroadcast      Permission = "broadcast"       // 广播消息
+	PermRetryWithdraw  Permission = "retry_withdraw"  // 重试提现
+	PermRefundWithdraw Permission = "refund_withdraw" // 退还提现
+	PermDeposit        Permission = "deposit"         // 为用户充值
+	PermAdjust         Permission = "adjust"          // 人工调账
+	PermFreeze         Permission = "freeze"          // 冻结和解冻账户
+	PermBackup         Permission = "backup"          // 备份数据库
+	PermAuditLog       Permission = "audit_log"       // 查询操作日志
+)
+
+// 角色列表
+const (
+	RoleViewer   = "viewer"   // 只读
+	RoleOperator = "operator" // 运营
+	RoleFinance  = "finance"  // 财务
+	RoleAdmin    = "admin"    // 超级管理员
+)
+
+// 角色权限
+var rolePermissions = map[string][]Permission{
+	RoleViewer:   {PermView},
+	RoleOperator: {PermView, PermBroadcast, PermRetryWithdraw, PermFreeze},
+	RoleFinance: {PermView, PermRetryWithdraw, PermRefundWithdraw, PermDeposit, PermAdjust,
+		PermFreeze, PermAuditLog},
+	RoleAdmin: {PermView, PermBroadcast, PermRetryWithdraw, PermRefundWithdraw, PermDeposit,
+		PermAdjust, PermFreeze, PermBackup, PermAuditLog},
+}
+
+// IsValidRole 是否有效角色
+func IsValidRole(role string) bool {
+	_, ok := rolePermissions[role]
+	return ok
+}
+
+// Roles 角色列表
+func Roles() []string {
+	roles := make([]string, 0, len(rolePermissions))
+	for role := range rolePermissions {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+// HasPermission 角色是否拥有权限, 空权限表示只需登录
+func HasPermission(role string, perm Permission) bool {
+	if len(perm) == 0 {
+		return true
+	}
+	for _, item := range rolePermissions[role] {
+		if item == perm {
+			return true
+		}
+	}
+	return false
+}
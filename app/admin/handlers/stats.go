@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/zhangpanyi/basebot/logger"
+	"luckybot/app/storage/models"
+)
+
+// 默认统计天数
+const defaultStatsDays = 30
+
+// 最大统计天数
+const maxStatsDays = 366
+
+// GetStatsRequest 获取统计请求
+type GetStatsRequest struct {
+	Days int `json:"days"` // 统计天数
+}
+
+// GetStatsRespone 获取统计响应
+type GetStatsRespone struct {
+	Totals []*models.AssetTotals `json:"totals"` // 资产总量
+	Daily  []*models.DailyStats  `json:"daily"`  // 每日统计
+}
+
+// GetStats 获取全局统计
+func GetStats(w http.ResponseWriter, r *http.Request) {
+	// 解析请求参数
+	var request GetStatsRequest
+	if err := readRequest(r, &request); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+	if request.Days <= 0 {
+		request.Days = defaultStatsDays
+	}
+	if request.Days > maxStatsDays {
+		request.Days = maxStatsDays
+	}
+
+	// 查询统计数据
+	model := models.StatsModel{}
+	totals, err := model.GetTotals()
+	if err != nil {
+		logger.Warnf("Failed to query stats totals, %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+	daily, err := model.GetDaily(request.Days)
+	if err != nil {
+		logger.Warnf("Failed to query daily stats, %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+
+	respone := GetStatsRespone{Totals: totals, Daily: daily}
+	jsb, err := json.Marshal(respone)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+
+	// 返回统计数据
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(makeRespone(jsb))
+}
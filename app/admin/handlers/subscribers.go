@@ -14,22 +14,11 @@ type GetSubscribersRequest struct {
 
 // Subscribers 获取订户
 func Subscribers(w http.ResponseWriter, r *http.Request) {
-	// 跨域访问
-	allowAccessControl(w)
-
-	// 验证权限
-	sessionID, data, ok := authentication(r)
-	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		_, _ = w.Write(makeErrorRespone("", ""))
-		return
-	}
-
 	// 解析请求参数
 	var request GetSubscribersRequest
-	if err := json.Unmarshal(data, &request); err != nil {
+	if err := readRequest(r, &request); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
-		_, _ = w.Write(makeErrorRespone(sessionID, err.Error()))
+		_, _ = w.Write(makeErrorRespone(err.Error()))
 		return
 	}
 
@@ -38,7 +27,7 @@ func Subscribers(w http.ResponseWriter, r *http.Request) {
 	users, err := model.GetSubscribers()
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
-		_, _ = w.Write(makeErrorRespone(sessionID, err.Error()))
+		_, _ = w.Write(makeErrorRespone(err.Error()))
 		return
 	}
 
@@ -46,10 +35,10 @@ func Subscribers(w http.ResponseWriter, r *http.Request) {
 	jsb, err := json.Marshal(&users)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
-		_, _ = w.Write(makeErrorRespone(sessionID, err.Error()))
+		_, _ = w.Write(makeErrorRespone(err.Error()))
 		return
 	}
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write(makeRespone(sessionID, jsb))
+	_, _ = w.Write(makeRespone(jsb))
 }
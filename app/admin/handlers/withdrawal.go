@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/zhangpanyi/basebot/logger"
+	"luckybot/app/logic/withdraw"
+	"luckybot/app/storage/models"
+)
+
+// GetWithdrawalsRequest 获取提现请求
+type GetWithdrawalsRequest struct {
+	State  models.WithdrawState `json:"state"`  // 提现状态
+	Offset uint                 `json:"offset"` // 偏移量
+	Limit  uint                 `json:"limit"`  // 返回数量
+	Tonce  int64                `json:"tonce"`  // 时间戳
+}
+
+// GetWithdrawalsRespone 获取提现响应
+type GetWithdrawalsRespone struct {
+	Sum    int                  `json:"sum"`    // 提现总量
+	Count  int                  `json:"count"`  // 返回数量
+	Result []*models.Withdrawal `json:"result"` // 提现列表
+}
+
+// GetWithdrawals 获取提现列表
+func GetWithdrawals(w http.ResponseWriter, r *http.Request) {
+	// 解析请求参数
+	var request GetWithdrawalsRequest
+	if err := readRequest(r, &request); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+
+	// 查询提现列表
+	model := models.WithdrawalModel{}
+	withdrawals, sum, err := model.GetWithdrawals(request.State, request.Offset, request.Limit, true)
+	if err != nil {
+		logger.Warnf("Failed to query withdrawals, %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+	respone := GetWithdrawalsRespone{Sum: sum, Count: len(withdrawals), Result: withdrawals}
+	jsb, err := json.Marshal(respone)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+
+	// 返回提现列表
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(makeRespone(jsb))
+}
+
+// RetryWithdrawalRequest 重试提现请求
+type RetryWithdrawalRequest struct {
+	ID    uint64 `json:"id"`    // 提现ID
+	Tonce int64  `json:"tonce"` // 时间戳
+}
+
+// RetryWithdrawalRespone 重试提现响应
+type RetryWithdrawalRespone struct {
+	OK bool `json:"ok"` // 是否成功
+}
+
+// RetryWithdrawal 重试提现
+func RetryWithdrawal(w http.ResponseWriter, r *http.Request) {
+	// 解析请求参数
+	var request RetryWithdrawalRequest
+	if err := readRequest(r, &request); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+
+	// 重新提交提现
+	if err := withdraw.Retry(request.ID); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+	logger.Warnf("Retry withdrawal by admin, id: %d", request.ID)
+
+	respone := RetryWithdrawalRespone{OK: true}
+	jsb, err := json.Marshal(&respone)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+
+	// 返回结果
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(makeRespone(jsb))
+}
+
+// RefundWithdrawalRequest 退还提现请求
+type RefundWithdrawalRequest struct {
+	ID     uint64 `json:"id"`     // 提现ID
+	Reason string `json:"reason"` // 退还原因
+	Tonce  int64  `json:"tonce"`  // 时间戳
+}
+
+// RefundWithdrawalRespone 退还提现响应
+type RefundWithdrawalRespone struct {
+	OK bool `json:"ok"` // 是否成功
+}
+
+// RefundWithdrawal 退还提现
+func RefundWithdrawal(w http.ResponseWriter, r *http.Request) {
+	// 解析请求参数
+	var request RefundWithdrawalRequest
+	if err := readRequest(r, &request); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+	if len(request.Reason) == 0 {
+		request.Reason = "refunded by admin"
+	}
+
+	// 退还提现资产
+	if err := withdraw.Refund(request.ID, request.Reason); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+	logger.Warnf("Refund withdrawal by admin, id: %d, reason: %s", request.ID, request.Reason)
+
+	respone := RefundWithdrawalRespone{OK: true}
+	jsb, err := json.Marshal(&respone)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write(makeErrorRespone(err.Error()))
+		return
+	}
+
+	// 返回结果
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(makeRespone(jsb))
+}
@@ -1,36 +1,192 @@
 package config
 
 import (
+	"fmt"
+	"net"
 	"os"
 	"path/filepath"
 	"strings"
 	"sync"
 
 	"github.com/zhangpanyi/basebot/logger"
+	"luckybot/app/fmath"
 
 	"github.com/fsnotify/fsnotify"
 	"gopkg.in/yaml.v2"
 )
 
+// Asset 资产配置, 金额使用十进制字符串
+type Asset struct {
+	Symbol             string `yaml:"symbol"`               // 资产符号
+	Name               string `yaml:"name"`                 // 资产名称
+	Precision          int    `yaml:"precision"`            // 资产精度
+	Fee                string `yaml:"fee"`                  // 提现手续费
+	MinWithdraw        string `yaml:"min_withdraw"`         // 最小提现金额
+	ThumbURL           string `yaml:"thumb_url"`            // 红包缩略图URL
+	TransferDailyLimit string `yaml:"transfer_daily_limit"` // 每日转账限额
+
+	fee                *fmath.Amount
+	minWithdraw        *fmath.Amount
+	transferDailyLimit *fmath.Amount
+}
+
+// FeeAmount 提现手续费
+func (asset *Asset) FeeAmount() *fmath.Amount {
+	return new(fmath.Amount).Set(asset.fee)
+}
+
+// MinWithdrawAmount 最小提现金额
+func (asset *Asset) MinWithdrawAmount() *fmath.Amount {
+	return new(fmath.Amount).Set(asset.minWithdraw)
+}
+
+// TransferDailyLimitAmount 每日转账限额, 0表示不限制
+func (asset *Asset) TransferDailyLimitAmount() *fmath.Amount {
+	return new(fmath.Amount).Set(asset.transferDailyLimit)
+}
+
+// 解析金额配置
+func (asset *Asset) parseAmounts() error {
+	fields := []struct {
+		name   string
+		text   string
+		amount **fmath.Amount
+	}{
+		{"fee", asset.Fee, &asset.fee},
+		{"min_withdraw", asset.MinWithdraw, &asset.minWithdraw},
+		{"transfer_daily_limit", asset.TransferDailyLimit, &asset.transferDailyLimit},
+	}
+	for _, field := range fields {
+		if len(strings.TrimSpace(field.text)) == 0 {
+			*field.amount = fmath.Zero()
+			continue
+		}
+		amount, err := fmath.Parse(field.text, asset.Precision)
+		if err != nil || amount.Sign() < 0 {
+			return fmt.Errorf("invalid %s %s: %q", asset.Symbol, field.name, field.text)
+		}
+		*field.amount = amount
+	}
+	return nil
+}
+
+// 更新模式
+const (
+	ModePolling = "polling" // 长轮询
+	ModeWebhook = "webhook" // Webhook
+)
+
+// Webhook Webhook配置
+type Webhook struct {
+	URL            string `yaml:"url"`             // 回调地址
+	SecretToken    string `yaml:"secret_token"`    // 验证密钥
+	MaxConnections int32  `yaml:"max_connections"` // 最大连接数
+	Certificate    string `yaml:"certificate"`     // 自签名证书路径
+}
+
+// Script 脚本配置
+type Script struct {
+	PoolSize        int `yaml:"pool_size"`         // 虚拟机数量
+	Timeout         int `yaml:"timeout"`           // 调用超时(毫秒)
+	CallStackSize   int `yaml:"call_stack_size"`   // 调用栈大小
+	RegistryMaxSize int `yaml:"registry_max_size"` // 数据栈上限
+}
+
+// DepositAPI 充值接口配置
+type DepositAPI struct {
+	Secret     string   `yaml:"secret"`      // 签名密钥
+	MaxSkew    int64    `yaml:"max_skew"`    // 最大时间偏差(秒)
+	AllowedIPs []string `yaml:"allowed_ips"` // IP白名单
+}
+
+// Admin 管理后台配置
+type Admin struct {
+	SessionTTL int64 `yaml:"session_ttl"` // 会话有效期(秒)
+}
+
+// TLS 证书配置
+type TLS struct {
+	CertFile string `yaml:"cert_file"` // 证书路径
+	KeyFile  string `yaml:"key_file"`  // 私钥路径
+}
+
+// Enabled 是否启用TLS
+func (t *TLS) Enabled() bool {
+	return len(t.CertFile) > 0 && len(t.KeyFile) > 0
+}
+
 // Serve 服务配置
 type Serve struct {
-	Host              string  `yaml:"host"`                 // 主机地址
-	Port              int     `yaml:"port"`                 // HTTP端口
-	Test              bool    `yaml:"test"`                 // 测试模式
-	APIAccess         string  `yaml:"api_access"`           // API接入点
-	SupportStaff      *int64  `yaml:"support_staff"`        // 电报客服ID
-	SecretKey         string  `yaml:"secret_key"`           // 验证码密钥
-	Token             string  `yaml:"token"`                // 机器人token
-	Name              string  `yaml:"name"`                 // 资产名称
-	Symbol            string  `yaml:"symbol"`               // 资产符号
-	Precision         int     `yaml:"precision"`            // 资产精度
-	WithdrawFee       float64 `yaml:"withdraw_fee"`         // 提现手续费
-	BolTDBPath        string  `yaml:"boltdb_path"`          // BoltDB路径
-	Languages         string  `yaml:"languages"`            // 语言配置路径
-	Expire            uint32  `yaml:"expire"`               // 红包过期时间
-	MaxMessageLen     int     `yaml:"max_message_len"`      // 最大留言长度
-	MaxHistoryTextLen int     `yaml:"max_history_text_len"` // 历史文本长度
-	ThumbURL          string  `yaml:"thumb_url"`            // 红包缩略图URL
+	Host              string     `yaml:"host"`                 // 主机地址
+	Port              int        `yaml:"port"`                 // HTTP端口
+	TLS               TLS        `yaml:"tls"`                  // TLS证书
+	Test              bool       `yaml:"test"`                 // 测试模式
+	APIAccess         string     `yaml:"api_access"`           // API接入点
+	SupportStaff      *int64     `yaml:"support_staff"`        // 电报客服ID
+	Token             string     `yaml:"token"`                // 机器人token
+	Mode              string     `yaml:"mode"`                 // 更新模式
+	Webhook           Webhook    `yaml:"webhook"`              // Webhook配置
+	Name              string     `yaml:"name"`                 // 机器人名称
+	Assets            []Asset    `yaml:"assets"`               // 资产列表
+	BolTDBPath        string     `yaml:"boltdb_path"`          // BoltDB路径
+	Languages         string     `yaml:"languages"`            // 语言配置路径
+	DefaultLanguage   string     `yaml:"default_language"`     // 默认语言
+	Expire            uint32     `yaml:"expire"`               // 红包过期时间
+	MinExpire         uint32     `yaml:"min_expire"`           // 最短有效期
+	MaxExpire         uint32     `yaml:"max_expire"`           // 最长有效期
+	Distributors      []string   `yaml:"distributors"`         // 分配算法
+	MaxMessageLen     int        `yaml:"max_message_len"`      // 最大留言长度
+	MaxHistoryTextLen int        `yaml:"max_history_text_len"` // 历史文本长度
+	Script            Script     `yaml:"script"`               // 脚本配置
+	Deposit           DepositAPI `yaml:"deposit"`              // 充值接口
+	Admin             Admin      `yaml:"admin"`                // 管理后台
+}
+
+// GetAsset 获取资产配置
+func (serve *Serve) GetAsset(symbol string) (*Asset, bool) {
+	for i := 0; i < len(serve.Assets); i++ {
+		if serve.Assets[i].Symbol == symbol {
+			return &serve.Assets[i], true
+		}
+	}
+	return nil, false
+}
+
+// 预设有效期
+var expirePresets = []uint32{3600, 6 * 3600, 12 * 3600, 24 * 3600, 3 * 24 * 3600, 7 * 24 * 3600}
+
+// ExpireOptions 获取可选有效期, 未配置范围时只能使用默认有效期
+func (serve *Serve) ExpireOptions() []uint32 {
+	options := make([]uint32, 0, len(expirePresets))
+	if serve.MinExpire > 0 && serve.MaxExpire >= serve.MinExpire {
+		for _, expire := range expirePresets {
+			if expire >= serve.MinExpire && expire <= serve.MaxExpire {
+				options = append(options, expire)
+			}
+		}
+	}
+	if len(options) == 0 {
+		options = append(options, serve.Expire)
+	}
+	return options
+}
+
+// IsValidExpire 是否可选有效期
+func (serve *Serve) IsValidExpire(expire uint32) bool {
+	for _, option := range serve.ExpireOptions() {
+		if option == expire {
+			return true
+		}
+	}
+	return false
+}
+
+// Precision 获取资产精度, 未知资产使用默认资产精度
+func (serve *Serve) Precision(symbol string) int {
+	if asset, ok := serve.GetAsset(symbol); ok {
+		return asset.Precision
+	}
+	return serve.Assets[0].Precision
 }
 
 // 配置解析器
@@ -76,9 +232,53 @@ func LoadConfig(path string) {
 		if err != nil {
 			panic(err)
 		}
+		if len(serve.Assets) == 0 {
+			panic("assets must not be empty")
+		}
+		for i := range serve.Assets {
+			if err = serve.Assets[i].parseAmounts(); err != nil {
+				panic(err)
+			}
+		}
+		if len(serve.Mode) == 0 {
+			serve.Mode = ModePolling
+		}
+		if serve.Mode != ModePolling && serve.Mode != ModeWebhook {
+			panic("mode must be polling or webhook")
+		}
+		if serve.Mode == ModeWebhook {
+			if len(serve.Webhook.URL) == 0 || len(serve.Webhook.SecretToken) == 0 {
+				panic("webhook url and secret_token must not be empty")
+			}
+		}
+
+		if serve.Deposit.MaxSkew <= 0 {
+			serve.Deposit.MaxSkew = 300
+		}
+		for _, item := range serve.Deposit.AllowedIPs {
+			if net.ParseIP(item) == nil {
+				if _, _, err = net.ParseCIDR(item); err != nil {
+					panic("invalid deposit allowed_ips: " + item)
+				}
+			}
+		}
+
+		if serve.Admin.SessionTTL <= 0 {
+			serve.Admin.SessionTTL = 3600
+		}
+		if (len(serve.TLS.CertFile) == 0) != (len(serve.TLS.KeyFile) == 0) {
+			panic("tls cert_file and key_file must be set together")
+		}
 
 		// 加载语言包配置
 		languages, files := readLanguages(serve.Languages)
+		if len(serve.DefaultLanguage) == 0 {
+			serve.DefaultLanguage = DefaultLanguage
+		}
+		if !languages.Exist(serve.DefaultLanguage) {
+			panic("default_language not found in languages")
+		}
+		languages.defaultCode = serve.DefaultLanguage
 		for _, filename := range files {
 			err = watcher.Add(filename)
 			if err != nil {
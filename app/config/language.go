@@ -3,13 +3,19 @@ package config
 import (
 	"encoding/json"
 	"errors"
+	"sort"
+	"strings"
 	"sync"
 )
 
+// DefaultLanguage 默认语言
+const DefaultLanguage = "zh_CN"
+
 // Languges 语言包配置
 type Languges struct {
-	lock sync.RWMutex
-	priv map[string]privLanguges
+	lock        sync.RWMutex
+	priv        map[string]privLanguges
+	defaultCode string
 }
 
 type privLanguges map[string]string
@@ -17,25 +23,90 @@ type privLanguges map[string]string
 // NewLanguges 创建语言包
 func NewLanguges() *Languges {
 	return &Languges{
-		priv: make(map[string]privLanguges),
+		priv:        make(map[string]privLanguges),
+		defaultCode: DefaultLanguage,
 	}
 }
 
-// Value 获取配置
+// Value 获取配置, 缺失时使用默认语言
 func (l *Languges) Value(code string, key string) string {
 	l.lock.RLock()
 	defer l.lock.RUnlock()
 
-	lang, ok := l.priv[code]
-	if !ok {
-		return ""
+	if lang, ok := l.priv[code]; ok {
+		if val, ok := lang[key]; ok {
+			return val
+		}
 	}
 
-	val, ok := lang[key]
-	if !ok {
-		return ""
+	if lang, ok := l.priv[l.defaultCode]; ok {
+		return lang[key]
+	}
+	return ""
+}
+
+// Default 默认语言代码
+func (l *Languges) Default() string {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	return l.defaultCode
+}
+
+// Codes 语言代码列表
+func (l *Languges) Codes() []string {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	codes := make([]string, 0, len(l.priv))
+	for code := range l.priv {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// Exist 语言是否存在
+func (l *Languges) Exist(code string) bool {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	_, ok := l.priv[code]
+	return ok
+}
+
+// Match 匹配电报语言代码, 如 en, en-US, zh-hans
+func (l *Languges) Match(languageCode string) (string, bool) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	normalized := strings.ToLower(strings.Replace(languageCode, "-", "_", -1))
+	if len(normalized) == 0 {
+		return "", false
+	}
+
+	// 完全匹配
+	for code := range l.priv {
+		if strings.ToLower(code) == normalized {
+			return code, true
+		}
+	}
+
+	// 匹配主语言
+	base := strings.SplitN(normalized, "_", 2)[0]
+	if strings.HasPrefix(strings.ToLower(l.defaultCode), base+"_") {
+		return l.defaultCode, true
+	}
+	codes := make([]string, 0, len(l.priv))
+	for code := range l.priv {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		if strings.HasPrefix(strings.ToLower(code), base+"_") {
+			return code, true
+		}
 	}
-	return val
+	return "", false
 }
 
 // 解析数据
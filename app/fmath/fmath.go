@@ -1,36 +1,235 @@
 package fmath
 
 import (
+	"errors"
 	"math/big"
+	"strconv"
+	"strings"
 )
 
-var prec uint
+var (
+	// ErrInvalidAmount 无效金额
+	ErrInvalidAmount = errors.New("invalid amount")
+	// ErrTooManyDecimals 小数位数过多
+	ErrTooManyDecimals = errors.New("too many decimals")
+)
+
+// Amount 定点金额
+// 以资产最小单位(10^-precision)保存整数值, 避免二进制浮点误差
+type Amount struct {
+	value big.Int
+}
+
+// NewAmount 创建金额(最小单位)
+func NewAmount(units int64) *Amount {
+	amount := new(Amount)
+	amount.value.SetInt64(units)
+	return amount
+}
+
+// Zero 零值
+func Zero() *Amount {
+	return new(Amount)
+}
+
+// Parse 解析十进制金额字符串
+func Parse(s string, precision int) (*Amount, error) {
+	return parse(s, precision, false)
+}
+
+// FromFloat 浮点数转换为金额, 超出精度部分四舍五入
+func FromFloat(f float64, precision int) *Amount {
+	amount, err := parse(strconv.FormatFloat(f, 'f', -1, 64), precision, true)
+	if err != nil {
+		return Zero()
+	}
+	return amount
+}
+
+// 解析十进制金额
+func parse(s string, precision int, round bool) (*Amount, error) {
+	if precision < 0 {
+		precision = 0
+	}
+
+	// 处理符号
+	s = strings.TrimSpace(s)
+	negative := false
+	if strings.HasPrefix(s, "-") {
+		negative = true
+		s = s[1:]
+	} else if strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+
+	// 拆分整数和小数
+	integer, fraction := s, ""
+	if idx := strings.IndexByte(s, '.'); idx >= 0 {
+		integer, fraction = s[:idx], s[idx+1:]
+	}
+	if len(integer) == 0 && len(fraction) == 0 {
+		return nil, ErrInvalidAmount
+	}
+	if !isDigits(integer) || !isDigits(fraction) {
+		return nil, ErrInvalidAmount
+	}
+
+	// 检查小数位数
+	carry := false
+	if len(fraction) > precision {
+		if !round {
+			if strings.TrimRight(fraction[precision:], "0") != "" {
+				return nil, ErrTooManyDecimals
+			}
+		} else {
+			carry = fraction[precision] >= '5'
+		}
+		fraction = fraction[:precision]
+	}
+	fraction += strings.Repeat("0", precision-len(fraction))
+
+	amount := new(Amount)
+	if _, ok := amount.value.SetString("0"+integer+fraction, 10); !ok {
+		return nil, ErrInvalidAmount
+	}
+	if carry {
+		amount.value.Add(&amount.value, big.NewInt(1))
+	}
+	if negative {
+		amount.value.Neg(&amount.value)
+	}
+	return amount, nil
+}
+
+// 是否全部为数字
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Set 赋值
+func (amount *Amount) Set(x *Amount) *Amount {
+	amount.value.Set(&x.value)
+	return amount
+}
+
+// Add 相加
+func (amount *Amount) Add(x, y *Amount) *Amount {
+	amount.value.Add(&x.value, &y.value)
+	return amount
+}
+
+// Sub 相减
+func (amount *Amount) Sub(x, y *Amount) *Amount {
+	amount.value.Sub(&x.value, &y.value)
+	return amount
+}
+
+// Mul 乘以整数
+func (amount *Amount) Mul(x *Amount, n int64) *Amount {
+	amount.value.Mul(&x.value, big.NewInt(n))
+	return amount
+}
+
+// Neg 取反
+func (amount *Amount) Neg(x *Amount) *Amount {
+	amount.value.Neg(&x.value)
+	return amount
+}
+
+// Abs 取绝对值
+func (amount *Amount) Abs(x *Amount) *Amount {
+	amount.value.Abs(&x.value)
+	return amount
+}
+
+// Cmp 比较大小
+func (amount *Amount) Cmp(y *Amount) int {
+	return amount.value.Cmp(&y.value)
+}
+
+// Sign 符号
+func (amount *Amount) Sign() int {
+	return amount.value.Sign()
+}
 
-func init() {
-	prec = big.NewFloat(0).Prec()
+// Units 最小单位整数值
+func (amount *Amount) Units() *big.Int {
+	return new(big.Int).Set(&amount.value)
 }
 
-// Prec 精度
-func Prec() uint {
-	return prec
+// SetUnits 设置最小单位整数值
+func (amount *Amount) SetUnits(units *big.Int) *Amount {
+	amount.value.Set(units)
+	return amount
+}
+
+// String 最小单位字符串
+func (amount *Amount) String() string {
+	return amount.value.String()
+}
+
+// Format 格式化为十进制字符串
+func (amount *Amount) Format(precision int) string {
+	if precision <= 0 {
+		return amount.value.String()
+	}
+
+	digits := new(big.Int).Abs(&amount.value).String()
+	if len(digits) <= precision {
+		digits = strings.Repeat("0", precision-len(digits)+1) + digits
+	}
+	integer := digits[:len(digits)-precision]
+	fraction := strings.TrimRight(digits[len(digits)-precision:], "0")
+
+	s := integer
+	if len(fraction) > 0 {
+		s += "." + fraction
+	}
+	if amount.value.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// MarshalText 序列化
+func (amount *Amount) MarshalText() ([]byte, error) {
+	return amount.value.MarshalText()
+}
+
+// UnmarshalText 反序列化
+func (amount *Amount) UnmarshalText(text []byte) error {
+	if _, ok := amount.value.SetString(string(text), 10); !ok {
+		return ErrInvalidAmount
+	}
+	return nil
 }
 
 // Add 相加
-func Add(x *big.Float, y *big.Float) *big.Float {
-	return big.NewFloat(0).Add(x, y)
+func Add(x *Amount, y *Amount) *Amount {
+	return new(Amount).Add(x, y)
 }
 
 // Sub 相减
-func Sub(x *big.Float, y *big.Float) *big.Float {
-	return big.NewFloat(0).Sub(x, y)
+func Sub(x *Amount, y *Amount) *Amount {
+	return new(Amount).Sub(x, y)
+}
+
+// Mul 乘以整数
+func Mul(x *Amount, n int64) *Amount {
+	return new(Amount).Mul(x, n)
 }
 
-// Mul 相乘
-func Mul(x *big.Float, y *big.Float) *big.Float {
-	return big.NewFloat(0).Mul(x, y)
+// Neg 取反
+func Neg(x *Amount) *Amount {
+	return new(Amount).Neg(x)
 }
 
 // Abs 取绝对值
-func Abs(x *big.Float) *big.Float {
-	return big.NewFloat(0).Abs(x)
+func Abs(x *Amount) *Amount {
+	return new(Amount).Abs(x)
 }
@@ -0,0 +1,88 @@
+package fmath
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		s         string
+		precision int
+		units     string
+		err       error
+	}{
+		{"1.5", 2, "150", nil},
+		{"  +1.5 ", 2, "150", nil},
+		{"-1.05", 2, "-105", nil},
+		{".5", 2, "50", nil},
+		{"3.", 2, "300", nil},
+		{"1.230", 2, "123", nil},
+		{"7", 0, "7", nil},
+		{"1.234", 2, "", ErrTooManyDecimals},
+		{"-0.001", 2, "", ErrTooManyDecimals},
+		{"", 2, "", ErrInvalidAmount},
+		{"-", 2, "", ErrInvalidAmount},
+		{".", 2, "", ErrInvalidAmount},
+		{"1e5", 2, "", ErrInvalidAmount},
+		{"--1", 2, "", ErrInvalidAmount},
+		{"1.2.3", 2, "", ErrInvalidAmount},
+	}
+	for _, c := range cases {
+		amount, err := Parse(c.s, c.precision)
+		if err != c.err {
+			t.Fatalf("Parse(%q, %d) error = %v, want %v", c.s, c.precision, err, c.err)
+		}
+		if err == nil && amount.String() != c.units {
+			t.Fatalf("Parse(%q, %d) = %s, want %s", c.s, c.precision, amount, c.units)
+		}
+	}
+}
+
+func TestFromFloat(t *testing.T) {
+	cases := []struct {
+		f         float64
+		precision int
+		units     string
+	}{
+		{0.1, 8, "10000000"},
+		{1.005, 2, "101"},
+		{1.004, 2, "100"},
+		{-1.005, 2, "-101"},
+		{0.125, 2, "13"},
+		{2.5, 0, "3"},
+		{123456.789, 2, "12345679"},
+	}
+	for _, c := range cases {
+		if amount := FromFloat(c.f, c.precision); amount.String() != c.units {
+			t.Fatalf("FromFloat(%v, %d) = %s, want %s", c.f, c.precision, amount, c.units)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	cases := []struct {
+		units     int64
+		precision int
+		s         string
+	}{
+		{150, 2, "1.5"},
+		{100, 2, "1"},
+		{0, 2, "0"},
+		{5, 2, "0.05"},
+		{1, 8, "0.00000001"},
+		{-5, 2, "-0.05"},
+		{-150, 2, "-1.5"},
+		{-123, 0, "-123"},
+	}
+	for _, c := range cases {
+		if s := NewAmount(c.units).Format(c.precision); s != c.s {
+			t.Fatalf("NewAmount(%d).Format(%d) = %s, want %s", c.units, c.precision, s, c.s)
+		}
+	}
+
+	// 格式化后解析得到原值
+	for _, c := range cases {
+		amount, err := Parse(NewAmount(c.units).Format(c.precision), c.precision)
+		if err != nil || amount.Cmp(NewAmount(c.units)) != 0 {
+			t.Fatalf("round trip %d = %v, %v", c.units, amount, err)
+		}
+	}
+}
@@ -1,5 +1,14 @@
 package future
 
+import (
+	"errors"
+	"time"
+)
+
+// ErrTimeout 等待结果超时
+var ErrTimeout = errors.New("future timeout")
+
+// Future 异步结果
 type Future struct {
 	id string
 	ch chan result
@@ -11,9 +20,9 @@ type result struct {
 	txid string
 }
 
-// 创建Future
+// 创建Future, 结果只设置一次, 设置时不阻塞
 func newFuture(id string) *Future {
-	ch := make(chan result)
+	ch := make(chan result, 1)
 	return &Future{ch: ch, id: id}
 }
 
@@ -28,6 +37,18 @@ func (f *Future) GetResult() (string, error) {
 	return r.txid, r.err
 }
 
+// GetResultTimeout 获取结果, 超时返回ErrTimeout
+func (f *Future) GetResultTimeout(timeout time.Duration) (string, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case r := <-f.ch:
+		return r.txid, r.err
+	case <-timer.C:
+		return "", ErrTimeout
+	}
+}
+
 // SetResult 设置结果
 func (f *Future) SetResult(txid string, err error) {
 	f.ch <- result{txid: txid, err: err}
@@ -0,0 +1,34 @@
+package future
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFutureResult(t *testing.T) {
+	NewFutureManagerOnce()
+
+	// 调用方返回前设置结果不阻塞, 重复设置被忽略
+	f := Manager.NewFuture()
+	if !Manager.SetResult(f.ID(), "txid", nil) {
+		t.Fatal("expected first result to be set")
+	}
+	if Manager.SetResult(f.ID(), "", errors.New("unknown")) {
+		t.Fatal("expected second result to be ignored")
+	}
+	txid, err := f.GetResultTimeout(time.Second)
+	if err != nil || txid != "txid" {
+		t.Fatalf("GetResultTimeout() = %q, %v", txid, err)
+	}
+
+	// 超时后移除, 之后设置的结果被忽略
+	f = Manager.NewFuture()
+	if _, err = f.GetResultTimeout(10 * time.Millisecond); err != ErrTimeout {
+		t.Fatalf("expected ErrTimeout, got %v", err)
+	}
+	Manager.Remove(f.ID())
+	if Manager.SetResult(f.ID(), "txid", nil) {
+		t.Fatal("expected result after remove to be ignored")
+	}
+}
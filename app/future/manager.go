@@ -29,16 +29,24 @@ func (m *FutureManager) NewFuture() *Future {
 	return future
 }
 
-// SetResult 设置结果
-func (m *FutureManager) SetResult(id, txid string, err error) {
+// SetResult 设置结果, 返回是否设置成功, 已设置或已移除时忽略
+func (m *FutureManager) SetResult(id, txid string, err error) bool {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 	future, ok := m.futures[id]
 	if !ok {
-		return
+		return false
 	}
 	future.SetResult(txid, err)
 	delete(m.futures, id)
+	return true
+}
+
+// Remove 移除Future, 之后设置的结果将被忽略
+func (m *FutureManager) Remove(id string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.futures, id)
 }
 
 // NewFutureManagerOnce 创建Future管理器
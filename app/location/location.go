@@ -23,3 +23,25 @@ func Format(timestamp int64) string {
 	utctime := time.Unix(timestamp, 0)
 	return utctime.In(loc).Format(RFC3339LITE)
 }
+
+// Now 当前本地时间
+func Now() time.Time {
+	return time.Now().In(loc)
+}
+
+// ParseInLocation 解析本地时间
+func ParseInLocation(layout, value string) (time.Time, error) {
+	return time.ParseInLocation(layout, value, loc)
+}
+
+// Date 格式化日期
+func Date(timestamp int64) string {
+	utctime := time.Unix(timestamp, 0)
+	return utctime.In(loc).Format("2006-01-02")
+}
+
+// FormatLayout 按指定格式格式化时间
+func FormatLayout(timestamp int64, layout string) string {
+	utctime := time.Unix(timestamp, 0)
+	return utctime.In(loc).Format(layout)
+}
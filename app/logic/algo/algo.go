@@ -4,7 +4,11 @@ import (
 	"errors"
 	"math/big"
 	"math/rand"
+	"sort"
+	"sync"
 	"time"
+
+	"luckybot/app/fmath"
 )
 
 var (
@@ -12,41 +16,124 @@ var (
 	ErrTooLittleMoney = errors.New("each person is at least 0.01")
 	// ErrorTooLittleNumber 红包数量太少
 	ErrorTooLittleNumber = errors.New("number must be more than 0")
+	// ErrUnknownDistributor 未知分配算法
+	ErrUnknownDistributor = errors.New("unknown distributor")
+	// ErrInvalidShares 分配结果无效
+	ErrInvalidShares = errors.New("invalid shares")
 )
 
-// Generate 生成算法
-func Generate(amount *big.Float, precision, number int) ([]*big.Float, error) {
-	if precision < 0 {
-		precision = 0
+// DefaultDistributor 默认分配算法
+const DefaultDistributor = "double_mean"
+
+// Distributor 分配算法, 金额以最小单位表示
+type Distributor interface {
+	// Name 算法名称
+	Name() string
+	// Distribute 将金额拆分为指定份数
+	Distribute(amount *big.Int, number int) ([]*big.Int, error)
+}
+
+var lock sync.RWMutex
+var distributors = make(map[string]Distributor)
+
+func init() {
+	Register(doubleMean{})
+	Register(normal{})
+	Register(jackpot{})
+	Register(luaScript{})
+}
+
+// Register 注册分配算法
+func Register(distributor Distributor) {
+	lock.Lock()
+	defer lock.Unlock()
+	distributors[distributor.Name()] = distributor
+}
+
+// Get 获取分配算法
+func Get(name string) (Distributor, bool) {
+	lock.RLock()
+	defer lock.RUnlock()
+	distributor, ok := distributors[name]
+	return distributor, ok
+}
+
+// Names 已注册算法列表
+func Names() []string {
+	lock.RLock()
+	defer lock.RUnlock()
+	names := make([]string, 0, len(distributors))
+	for name := range distributors {
+		names = append(names, name)
 	}
+	sort.Strings(names)
+	return names
+}
+
+// Generate 生成算法
+func Generate(amount *fmath.Amount, number int) ([]*fmath.Amount, error) {
+	return GenerateWith(DefaultDistributor, amount, number)
+}
 
-	base := big.NewInt(10)
-	base.Exp(base, big.NewInt(int64(precision)), nil)
-	wei, _ := big.NewFloat(0).SetString(base.String())
+// GenerateWith 使用指定算法生成
+func GenerateWith(name string, amount *fmath.Amount, number int) ([]*fmath.Amount, error) {
+	distributor, ok := Get(name)
+	if !ok {
+		return nil, ErrUnknownDistributor
+	}
+	if number < 1 {
+		return nil, ErrorTooLittleNumber
+	}
+	units := amount.Units()
+	if units.Cmp(big.NewInt(int64(number))) == -1 {
+		return nil, ErrTooLittleMoney
+	}
 
-	product := big.NewFloat(0).Mul(wei, amount)
-	newAmount, _ := product.Int(big.NewInt(0))
-	arr, err := generate(newAmount, number)
+	arr, err := distributor.Distribute(new(big.Int).Set(units), number)
 	if err != nil {
 		return nil, err
 	}
+	if err = verify(units, number, arr); err != nil {
+		return nil, err
+	}
 
-	result := make([]*big.Float, 0, number)
+	result := make([]*fmath.Amount, 0, number)
 	for i := 0; i < len(arr); i++ {
-		tmp := big.NewFloat(0).SetInt(arr[i])
-		result = append(result, tmp.Quo(tmp, wei))
+		result = append(result, fmath.Zero().SetUnits(arr[i]))
 	}
 	return result, nil
 }
 
+// 校验分配结果, 总和必须等于金额且每份至少一个最小单位
+func verify(amount *big.Int, number int, arr []*big.Int) error {
+	if len(arr) != number {
+		return ErrInvalidShares
+	}
+	one := big.NewInt(1)
+	sum := big.NewInt(0)
+	for _, value := range arr {
+		if value == nil || value.Cmp(one) == -1 {
+			return ErrInvalidShares
+		}
+		sum.Add(sum, value)
+	}
+	if sum.Cmp(amount) != 0 {
+		return ErrInvalidShares
+	}
+	return nil
+}
+
 // ZERO 零值
 var ZERO = big.NewInt(0)
 
 // 随机器
 var randx = rand.New(rand.NewSource(time.Now().UnixNano()))
+var randLock sync.Mutex
 
 // 打乱数组
 func randomShuffle(array []*big.Int) []*big.Int {
+	randLock.Lock()
+	defer randLock.Unlock()
 	for i := range array {
 		j := randx.Intn(i + 1)
 		array[i], array[j] = array[j], array[i]
@@ -59,36 +146,29 @@ func subBigInt(x *big.Int, y *big.Int) *big.Int {
 	return big.NewInt(0).Sub(x, y)
 }
 
-// 生成算法
-func generate(amount *big.Int, number int) ([]*big.Int, error) {
-	if amount.Cmp(ZERO) == -1 {
-		return nil, ErrTooLittleMoney
+// 按权重拆分, 每份先分一个最小单位, 剩余部分按权重分配
+func splitByWeights(amount *big.Int, weights []int64) []*big.Int {
+	number := len(weights)
+	rest := subBigInt(amount, big.NewInt(int64(number)))
+	total := big.NewInt(0)
+	for _, weight := range weights {
+		total.Add(total, big.NewInt(weight))
 	}
-	if number < 1 {
-		return nil, ErrorTooLittleNumber
+
+	result := make([]*big.Int, 0, number)
+	remain := new(big.Int).Set(rest)
+	for _, weight := range weights {
+		value := new(big.Int).Mul(rest, big.NewInt(weight))
+		value.Quo(value, total)
+		remain.Sub(remain, value)
+		result = append(result, value.Add(value, big.NewInt(1)))
 	}
-	return generateResultSet(amount, number)
-}
 
-// 生成结果集
-func generateResultSet(amount *big.Int, number int) ([]*big.Int, error) {
+	// 余数逐个分配
 	one := big.NewInt(1)
-	result := make([]*big.Int, 0, number)
-	for i := 1; i < number; i++ {
-		value := big.NewInt(1)
-		x, ok := big.NewFloat(0).SetString(
-			subBigInt(amount, big.NewInt(int64(number-1))).String())
-		if !ok {
-			return nil, errors.New("invalid number")
-		}
-		y := big.NewFloat(float64(number - i))
-		safeAmount, _ := big.NewFloat(0).Quo(x, y).Int(big.NewInt(0))
-		if safeAmount.Cmp(one) == 1 {
-			value.Add(one, big.NewInt(0).Rand(randx, safeAmount.Sub(safeAmount, one)))
-		}
-		amount.Sub(amount, value)
-		result = append(result, value)
+	for i := 0; remain.Sign() > 0; i = (i + 1) % number {
+		result[i].Add(result[i], one)
+		remain.Sub(remain, one)
 	}
-	result = append(result, amount)
-	return randomShuffle(result), nil
+	return result
 }
@@ -0,0 +1,119 @@
+package algo
+
+import (
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"luckybot/app/fmath"
+)
+
+// 每个算法的随机测试次数
+const propertyRounds = 500
+
+// 随机生成金额, 金额按精度保留小数且不少于份数个最小单位
+func randomAmount(r *rand.Rand, number int, precision int) *fmath.Amount {
+	units := new(big.Int).Rand(r, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(precision+6)), nil))
+	units.Add(units, big.NewInt(int64(number)))
+	text := units.String()
+	if precision > 0 {
+		if len(text) <= precision {
+			text = strings.Repeat("0", precision-len(text)+1) + text
+		}
+		text = text[:len(text)-precision] + "." + text[len(text)-precision:]
+	}
+	amount, err := fmath.Parse(text, precision)
+	if err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+// 检查分配结果
+func checkShares(t *testing.T, name string, amount *big.Int, number int, shares []*big.Int) {
+	t.Helper()
+	if len(shares) != number {
+		t.Fatalf("%s: amount=%s, number=%d, got %d shares", name, amount, number, len(shares))
+	}
+	sum := big.NewInt(0)
+	for i, share := range shares {
+		if share == nil || share.Sign() < 1 {
+			t.Fatalf("%s: amount=%s, number=%d, share %d is %v", name, amount, number, i, share)
+		}
+		sum.Add(sum, share)
+	}
+	if sum.Cmp(amount) != 0 {
+		t.Fatalf("%s: amount=%s, number=%d, sum of shares is %s", name, amount, number, sum)
+	}
+}
+
+func TestDistributorProperties(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, name := range Names() {
+		name := name
+		t.Run(name, func(t *testing.T) {
+			if name == "lua" {
+				t.Skip("lua distributor requires a running script engine")
+			}
+			distributor, ok := Get(name)
+			if !ok {
+				t.Fatalf("distributor %s not found", name)
+			}
+			for i := 0; i < propertyRounds; i++ {
+				number := 1 + r.Intn(100)
+				amount := randomAmount(r, number, r.Intn(19))
+
+				// 算法原始输出
+				units := amount.Units()
+				shares, err := distributor.Distribute(new(big.Int).Set(units), number)
+				if err != nil {
+					t.Fatal(err)
+				}
+				checkShares(t, name, units, number, shares)
+
+				// 经过校验的输出
+				result, err := GenerateWith(name, amount, number)
+				if err != nil {
+					t.Fatal(err)
+				}
+				values := make([]*big.Int, 0, len(result))
+				for _, value := range result {
+					values = append(values, value.Units())
+				}
+				checkShares(t, name, units, number, values)
+			}
+		})
+	}
+}
+
+func TestDistributorMinimumAmount(t *testing.T) {
+	for _, name := range Names() {
+		if name == "lua" {
+			continue
+		}
+		for number := 1; number <= 50; number++ {
+			result, err := GenerateWith(name, fmath.NewAmount(int64(number)), number)
+			if err != nil {
+				t.Fatalf("%s: number=%d, %v", name, number, err)
+			}
+			for _, value := range result {
+				if value.Cmp(fmath.NewAmount(1)) != 0 {
+					t.Fatalf("%s: number=%d, share is %s", name, number, value)
+				}
+			}
+		}
+	}
+}
+
+func TestGenerateRejectsInvalidInput(t *testing.T) {
+	if _, err := GenerateWith(DefaultDistributor, fmath.NewAmount(2), 3); err != ErrTooLittleMoney {
+		t.Fatalf("expected ErrTooLittleMoney, got %v", err)
+	}
+	if _, err := GenerateWith(DefaultDistributor, fmath.NewAmount(2), 0); err != ErrorTooLittleNumber {
+		t.Fatalf("expected ErrorTooLittleNumber, got %v", err)
+	}
+	if _, err := GenerateWith("unknown", fmath.NewAmount(2), 1); err != ErrUnknownDistributor {
+		t.Fatalf("expected ErrUnknownDistributor, got %v", err)
+	}
+}
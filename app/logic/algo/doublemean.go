@@ -0,0 +1,46 @@
+package algo
+
+import (
+	"math/big"
+)
+
+// 二倍均值算法
+type doubleMean struct {
+}
+
+// Name 算法名称
+func (doubleMean) Name() string {
+	return DefaultDistributor
+}
+
+// Distribute 拆分金额
+func (doubleMean) Distribute(amount *big.Int, number int) ([]*big.Int, error) {
+	if amount.Cmp(ZERO) == -1 {
+		return nil, ErrTooLittleMoney
+	}
+	if number < 1 {
+		return nil, ErrorTooLittleNumber
+	}
+	return generateResultSet(amount, number)
+}
+
+// 生成结果集
+func generateResultSet(amount *big.Int, number int) ([]*big.Int, error) {
+	one := big.NewInt(1)
+	result := make([]*big.Int, 0, number)
+	for i := 1; i < number; i++ {
+		value := big.NewInt(1)
+		x := subBigInt(amount, big.NewInt(int64(number-1)))
+		y := big.NewInt(int64(number - i))
+		safeAmount := big.NewInt(0).Quo(x, y)
+		if safeAmount.Cmp(one) == 1 {
+			randLock.Lock()
+			value.Add(one, big.NewInt(0).Rand(randx, safeAmount.Sub(safeAmount, one)))
+			randLock.Unlock()
+		}
+		amount.Sub(amount, value)
+		result = append(result, value)
+	}
+	result = append(result, amount)
+	return randomShuffle(result), nil
+}
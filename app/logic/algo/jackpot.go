@@ -0,0 +1,31 @@
+package algo
+
+import (
+	"math/big"
+)
+
+// 幸运大奖算法, 一人获得大部分金额, 其余平分
+type jackpot struct {
+}
+
+// Name 算法名称
+func (jackpot) Name() string {
+	return "jackpot"
+}
+
+// Distribute 拆分金额
+func (jackpot) Distribute(amount *big.Int, number int) ([]*big.Int, error) {
+	weights := make([]int64, number)
+	for i := range weights {
+		weights[i] = 1e6
+	}
+
+	// 大奖占比 50% ~ 75%
+	randLock.Lock()
+	weights[0] = int64(number-1) * (1e6 + randx.Int63n(2e6))
+	randLock.Unlock()
+	if number == 1 {
+		weights[0] = 1e6
+	}
+	return randomShuffle(splitByWeights(amount, weights)), nil
+}
@@ -0,0 +1,39 @@
+package algo
+
+import (
+	"errors"
+	"math/big"
+
+	"luckybot/app/logic/scriptengine"
+)
+
+// Lua脚本算法, 调用脚本中的 distribute 函数
+type luaScript struct {
+}
+
+// Name 算法名称
+func (luaScript) Name() string {
+	return "lua"
+}
+
+// Distribute 拆分金额
+func (luaScript) Distribute(amount *big.Int, number int) ([]*big.Int, error) {
+	glue := scriptengine.Engine()
+	if glue == nil {
+		return nil, errors.New("script engine not started")
+	}
+	values, err := glue.Distribute(amount.String(), number)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*big.Int, 0, len(values))
+	for _, value := range values {
+		units, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			return nil, ErrInvalidShares
+		}
+		result = append(result, units)
+	}
+	return result, nil
+}
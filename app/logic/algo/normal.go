@@ -0,0 +1,40 @@
+package algo
+
+import (
+	"math/big"
+)
+
+// 正态分布标准差系数
+const normalDeviation = 0.3
+
+// 正态分布权重上下限
+const (
+	normalMinWeight = 0.1
+	normalMaxWeight = 1.9
+)
+
+// 正态分布算法, 每份金额围绕平均值波动
+type normal struct {
+}
+
+// Name 算法名称
+func (normal) Name() string {
+	return "normal"
+}
+
+// Distribute 拆分金额
+func (normal) Distribute(amount *big.Int, number int) ([]*big.Int, error) {
+	weights := make([]int64, 0, number)
+	randLock.Lock()
+	for i := 0; i < number; i++ {
+		weight := 1 + randx.NormFloat64()*normalDeviation
+		if weight < normalMinWeight {
+			weight = normalMinWeight
+		} else if weight > normalMaxWeight {
+			weight = normalMaxWeight
+		}
+		weights = append(weights, int64(weight*1e6))
+	}
+	randLock.Unlock()
+	return randomShuffle(splitByWeights(amount, weights)), nil
+}
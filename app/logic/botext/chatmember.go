@@ -0,0 +1,52 @@
+package botext
+
+import (
+	"encoding/json"
+
+	"github.com/zhangpanyi/basebot/telegram/methods"
+)
+
+// 成员状态
+const (
+	memberCreator       = "creator"
+	memberAdministrator = "administrator"
+	memberMember        = "member"
+	memberRestricted    = "restricted"
+)
+
+// 获取成员请求
+type getChatMemberRequest struct {
+	ChatID int64 `json:"chat_id"` // 聊天ID
+	UserID int64 `json:"user_id"` // 用户ID
+}
+
+// 获取成员响应
+type getChatMemberResponse struct {
+	OK     bool `json:"ok"` // 是否成功
+	Result struct {
+		Status   string `json:"status"`              // 成员状态
+		IsMember bool   `json:"is_member,omitempty"` // 受限成员是否在群
+	} `json:"result"`
+}
+
+// IsChatMember 查询用户是否群组成员, 机器人必须在群组中
+func IsChatMember(bot *methods.BotExt, chatID, userID int64) (bool, error) {
+	request := getChatMemberRequest{ChatID: chatID, UserID: userID}
+	body, err := bot.Call("getChatMember", &request)
+	if err != nil {
+		return false, err
+	}
+
+	var response getChatMemberResponse
+	if err = json.Unmarshal(body, &response); err != nil {
+		return false, err
+	}
+
+	switch response.Result.Status {
+	case memberCreator, memberAdministrator, memberMember:
+		return true, nil
+	case memberRestricted:
+		return response.Result.IsMember, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,111 @@
+package deposit
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"luckybot/app/config"
+	"luckybot/app/storage/models"
+)
+
+// 签名请求头
+const (
+	HeaderTimestamp = "X-Timestamp" // Unix时间戳(秒)
+	HeaderNonce     = "X-Nonce"     // 随机字符串
+	HeaderSignature = "X-Signature" // HMAC-SHA256签名
+)
+
+// 随机数长度范围
+const (
+	minNonceLen = 8
+	maxNonceLen = 64
+)
+
+// Sign 计算请求签名
+func Sign(secret string, timestamp, nonce string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("\n"))
+	mac.Write([]byte(nonce))
+	mac.Write([]byte("\n"))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// 检查来源IP
+func checkRemoteIP(r *http.Request, allowed []string) *apiError {
+	if len(allowed) == 0 {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip != nil {
+		for _, item := range allowed {
+			if allowedIP := net.ParseIP(item); allowedIP != nil {
+				if allowedIP.Equal(ip) {
+					return nil
+				}
+				continue
+			}
+			if _, network, err := net.ParseCIDR(item); err == nil && network.Contains(ip) {
+				return nil
+			}
+		}
+	}
+	return newAPIError(http.StatusForbidden, CodeForbiddenIP, "ip not allowed: "+host)
+}
+
+// 验证请求签名
+func verifyRequest(r *http.Request, body []byte) *apiError {
+	serveCfg := config.GetServe()
+	if len(serveCfg.Deposit.Secret) == 0 {
+		return newAPIError(http.StatusServiceUnavailable, CodeDisabled, "deposit secret not configured")
+	}
+	if err := checkRemoteIP(r, serveCfg.Deposit.AllowedIPs); err != nil {
+		return err
+	}
+
+	// 检查时间戳
+	header := r.Header.Get(HeaderTimestamp)
+	timestamp, err := strconv.ParseInt(header, 10, 64)
+	if err != nil {
+		return newAPIError(http.StatusUnauthorized, CodeInvalidTimestamp, "invalid timestamp")
+	}
+	now := time.Now().Unix()
+	skew := serveCfg.Deposit.MaxSkew
+	if timestamp < now-skew || timestamp > now+skew {
+		return newAPIError(http.StatusUnauthorized, CodeInvalidTimestamp, "timestamp out of range")
+	}
+
+	// 检查签名
+	nonce := r.Header.Get(HeaderNonce)
+	if len(nonce) < minNonceLen || len(nonce) > maxNonceLen {
+		return newAPIError(http.StatusUnauthorized, CodeInvalidNonce, "invalid nonce")
+	}
+	signature, err := hex.DecodeString(r.Header.Get(HeaderSignature))
+	if err != nil {
+		return newAPIError(http.StatusUnauthorized, CodeInvalidSignature, "invalid signature")
+	}
+	expected, _ := hex.DecodeString(Sign(serveCfg.Deposit.Secret, header, nonce, body))
+	if !hmac.Equal(signature, expected) {
+		return newAPIError(http.StatusUnauthorized, CodeInvalidSignature, "invalid signature")
+	}
+
+	// 防止重放攻击
+	model := models.DepositNonceModel{}
+	if err = model.Use(nonce, timestamp, now-2*skew); err != nil {
+		if err == models.ErrNonceUsed {
+			return newAPIError(http.StatusUnauthorized, CodeReplayedNonce, err.Error())
+		}
+		return newAPIError(http.StatusInternalServerError, CodeInternalError, err.Error())
+	}
+	return nil
+}
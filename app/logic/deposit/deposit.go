@@ -2,16 +2,19 @@ package deposit
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"math/big"
 	"net/http"
 	"strconv"
 
 	"github.com/zhangpanyi/basebot/logger"
+	"luckybot/app/config"
+	"luckybot/app/fmath"
 	"luckybot/app/logic/handlers/utils"
 	"luckybot/app/logic/pusher"
 	"luckybot/app/logic/scriptengine"
+	"luckybot/app/luaglue"
 	"luckybot/app/storage/models"
 )
 
@@ -26,108 +29,214 @@ type DepositRequest struct {
 	Memo   string `json:"memo"`   // 备注信息
 }
 
-// 生成错误响应
-func makeErrorRespone(reason string) []byte {
+// 错误码
+const (
+	CodeDisabled           = "deposit_disabled"    // 未配置签名密钥
+	CodeForbiddenIP        = "forbidden_ip"        // IP不在白名单
+	CodeInvalidTimestamp   = "invalid_timestamp"   // 时间戳无效
+	CodeInvalidNonce       = "invalid_nonce"       // 随机数无效
+	CodeReplayedNonce      = "replayed_nonce"      // 随机数已使用
+	CodeInvalidSignature   = "invalid_signature"   // 签名无效
+	CodeInvalidBody        = "invalid_body"        // 读取数据失败
+	CodeInvalidRequest     = "invalid_request"     // 请求格式错误
+	CodeInvalidAsset       = "invalid_asset"       // 无效资产
+	CodeRepeatDeposit      = "repeat_deposit"      // 重复充值
+	CodeInvalidTransaction = "invalid_transaction" // 无效交易
+	CodeInvalidAmount      = "invalid_amount"      // 无效金额
+	CodeUserNotFound       = "user_not_found"      // 没有找到用户
+	CodeInternalError      = "internal_error"      // 内部错误
+)
+
+// 接口错误
+type apiError struct {
+	status  int
+	code    string
+	message string
+}
+
+// 创建接口错误
+func newAPIError(status int, code, message string) *apiError {
+	return &apiError{status: status, code: code, message: message}
+}
+
+// 输出错误响应
+func (e *apiError) write(w http.ResponseWriter) {
 	object := map[string]string{
-		"error": reason,
+		"code":  e.code,
+		"error": e.message,
 	}
 	jsb, _ := json.Marshal(&object)
-	return jsb
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(e.status)
+	_, _ = w.Write(jsb)
 }
 
-// HandleDeposit 充值处理
-func HandleDeposit(w http.ResponseWriter, r *http.Request) {
-	// 读取数据
-	defer r.Body.Close()
-	jsb, err := io.ReadAll(r.Body)
-	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		_, _ = w.Write(makeErrorRespone(fmt.Sprintf("invalid body, %v", err)))
-		return
-	}
+var (
+	// ErrInvalidAsset 无效资产
+	ErrInvalidAsset = errors.New("invalid asset")
+	// ErrRepeatDeposit 重复充值
+	ErrRepeatDeposit = errors.New("repeat deposit")
+	// ErrInvalidTransaction 无效交易
+	ErrInvalidTransaction = errors.New("invalid transaction")
+	// ErrInvalidAmount 无效金额
+	ErrInvalidAmount = errors.New("invalid amount")
+	// ErrUserNotFound 没有找到用户
+	ErrUserNotFound = errors.New("not found user")
+)
 
-	// 解析数据
-	var request DepositRequest
-	if err = json.Unmarshal(jsb, &request); err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		_, _ = w.Write(makeErrorRespone(fmt.Sprintf("invalid request, %v", err)))
-		return
+// Validator 交易验证器
+type Validator func(request *DepositRequest) bool
+
+// Credit 充值入账, data为保存的原始充值数据
+func Credit(request *DepositRequest, data []byte, validate Validator) error {
+	// 检查资产类型
+	serveCfg := config.GetServe()
+	asset, ok := serveCfg.GetAsset(request.Asset)
+	if !ok {
+		logger.Infof("Failed to deposit, invalid asset, txid: %s, asset: %s", request.TxID, request.Asset)
+		return ErrInvalidAsset
 	}
 
 	// 检查重复充值
 	depositModel := models.DepositModel{}
 	if depositModel.Exist(request.TxID) {
-		w.WriteHeader(http.StatusBadRequest)
-		_, _ = w.Write(makeErrorRespone("repeat deposit"))
-		return
+		return ErrRepeatDeposit
 	}
 
 	// 充值是否有效
-	ok := scriptengine.Engine.ValidTransaction(request.TxID, request.From, request.To,
-		request.Asset, request.Amount, request.Memo)
-	if !ok {
+	if !validate(request) {
 		logger.Infof("Failed to deposit, invalid transaction, txid: %s, from: %s, to: %s, asset: %s, amount: %s, memo: %s",
 			request.TxID, request.From, request.To, request.Asset, request.Amount, request.Memo)
-		w.WriteHeader(http.StatusBadRequest)
-		_, _ = w.Write(makeErrorRespone("invalid transaction"))
-		return
+		return ErrInvalidTransaction
 	}
 
 	// 获取充值金额
-	amount, ok := big.NewFloat(0).SetString(request.Amount)
-	if !ok {
+	amount, err := fmath.Parse(request.Amount, asset.Precision)
+	if err != nil || amount.Sign() <= 0 {
 		logger.Infof("Failed to deposit, amount invalid, amount: %s", request.Amount)
-		w.WriteHeader(http.StatusBadRequest)
-		_, _ = w.Write(makeErrorRespone("invalid amount"))
-		return
+		return ErrInvalidAmount
 	}
 
 	// 获取用户ID
 	userID, err := strconv.ParseInt(request.Memo, 10, 64)
 	if err != nil {
 		logger.Warnf("Failed to deposit, not found user id from memo, memo: %s, %v", request.Memo, err)
-		w.WriteHeader(http.StatusBadRequest)
-		_, _ = w.Write(makeErrorRespone("not found user"))
-		return
-	}
-
-	// 防止重复充值
-	if err = depositModel.Add(request.TxID, jsb); err != nil {
-		logger.Warnf("Failed to deposit, txid: %s, from: %s, to: %s, asset: %s, amount: %s, memo: %s, %v",
-			request.TxID, request.From, request.To, request.Asset, request.Amount, request.Memo, err)
-		w.WriteHeader(http.StatusInternalServerError)
-		_, _ = w.Write(makeErrorRespone("repeat deposit"))
-		return
-	}
-
-	// 增加用户资产
-	model := models.AccountModel{}
-	account, err := model.Deposit(userID, request.Asset, amount)
-	if err != nil {
-		logger.Warnf("Failed to deposit, txid: %s, from: %s, to: %s, asset: %s, amount: %s, memo: %s, %v",
-			request.TxID, request.From, request.To, request.Asset, request.Amount, request.Memo, err)
-		w.WriteHeader(http.StatusInternalServerError)
-		_, _ = w.Write(makeErrorRespone(fmt.Sprintf("deposit failure, %v", err)))
-		return
+		return ErrUserNotFound
 	}
 
-	// 写入充值记录
-	versionModel := models.AccountVersionModel{}
-	version, err := versionModel.InsertVersion(userID, &models.Version{
-		Symbol:         request.Asset,
+	// 增加用户资产, 充值记录与入账在同一事务中写入
+	_, version, err := depositModel.Credit(request.TxID, data, userID, request.Asset, amount, &models.Version{
 		Balance:        amount,
-		Amount:         account.Amount,
 		Reason:         models.ReasonDeposit,
 		RefTxID:        &request.TxID,
 		RefBlockHeight: &request.Height,
 	})
+	if errors.Is(err, models.ErrRepeatDeposit) {
+		return ErrRepeatDeposit
+	}
+	if err != nil {
+		logger.Warnf("Failed to deposit, txid: %s, from: %s, to: %s, asset: %s, amount: %s, memo: %s, %v",
+			request.TxID, request.From, request.To, request.Asset, request.Amount, request.Memo, err)
+		return fmt.Errorf("deposit failure, %v", err)
+	}
 
 	// 推送充值通知
-	if err == nil {
-		pusher.Post(userID, utils.MakeHistoryMessage(userID, version), true, nil)
+	pusher.Post(userID, utils.MakeHistoryMessage(userID, version), true, nil)
+
+	// 通知脚本引擎
+	glue := scriptengine.Engine()
+	if glue != nil {
+		go func() {
+			err := glue.OnDeposit(map[string]interface{}{
+				"txid":    request.TxID,
+				"height":  float64(request.Height),
+				"from":    request.From,
+				"to":      request.To,
+				"asset":   request.Asset,
+				"amount":  amount.Format(asset.Precision),
+				"memo":    request.Memo,
+				"user_id": strconv.FormatInt(userID, 10),
+			})
+			if err != nil {
+				logger.Warnf("Failed to call on_deposit, txid: %s, %v", request.TxID, err)
+			}
+		}()
 	}
 	logger.Warnf("Deposit success, txid: %s, from: %s, to: %s, asset: %s, amount: %s, memo: %s",
 		request.TxID, request.From, request.To, request.Asset, request.Amount, request.Memo)
+	return nil
+}
+
+// CreditFromScript 脚本充值入账
+func CreditFromScript(deposit *luaglue.Deposit, validate func(*luaglue.Deposit) bool) error {
+	request := DepositRequest{
+		TxID:   deposit.TxID,
+		Height: deposit.Height,
+		From:   deposit.From,
+		To:     deposit.To,
+		Asset:  deposit.Asset,
+		Amount: deposit.Amount,
+		Memo:   deposit.Memo,
+	}
+	data, err := json.Marshal(&request)
+	if err != nil {
+		return err
+	}
+	return Credit(&request, data, func(*DepositRequest) bool {
+		return validate(deposit)
+	})
+}
+
+// 入账错误转换为接口错误
+func toAPIError(err error) *apiError {
+	switch err {
+	case ErrInvalidAsset:
+		return newAPIError(http.StatusBadRequest, CodeInvalidAsset, err.Error())
+	case ErrRepeatDeposit:
+		return newAPIError(http.StatusConflict, CodeRepeatDeposit, err.Error())
+	case ErrInvalidTransaction:
+		return newAPIError(http.StatusBadRequest, CodeInvalidTransaction, err.Error())
+	case ErrInvalidAmount:
+		return newAPIError(http.StatusBadRequest, CodeInvalidAmount, err.Error())
+	case ErrUserNotFound:
+		return newAPIError(http.StatusBadRequest, CodeUserNotFound, err.Error())
+	}
+	return newAPIError(http.StatusInternalServerError, CodeInternalError, err.Error())
+}
+
+// HandleDeposit 充值处理
+func HandleDeposit(w http.ResponseWriter, r *http.Request) {
+	// 读取数据
+	defer r.Body.Close()
+	jsb, err := io.ReadAll(r.Body)
+	if err != nil {
+		newAPIError(http.StatusBadRequest, CodeInvalidBody, fmt.Sprintf("invalid body, %v", err)).write(w)
+		return
+	}
 
+	// 验证签名
+	if apiErr := verifyRequest(r, jsb); apiErr != nil {
+		logger.Warnf("Failed to deposit, reject request, remote: %s, code: %s, %s",
+			r.RemoteAddr, apiErr.code, apiErr.message)
+		apiErr.write(w)
+		return
+	}
+
+	// 解析数据
+	var request DepositRequest
+	if err = json.Unmarshal(jsb, &request); err != nil {
+		newAPIError(http.StatusBadRequest, CodeInvalidRequest, fmt.Sprintf("invalid request, %v", err)).write(w)
+		return
+	}
+
+	// 执行充值入账
+	err = Credit(&request, jsb, func(request *DepositRequest) bool {
+		return scriptengine.Engine().ValidTransaction(request.TxID, request.From, request.To,
+			request.Asset, request.Amount, request.Memo)
+	})
+	if err != nil {
+		toAPIError(err).write(w)
+		return
+	}
 	w.WriteHeader(http.StatusOK)
 }
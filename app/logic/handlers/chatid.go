@@ -0,0 +1,14 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/zhangpanyi/basebot/telegram/methods"
+	"github.com/zhangpanyi/basebot/telegram/types"
+)
+
+// ReplyChatID 回复群组ID
+func ReplyChatID(bot *methods.BotExt, message *types.Message) {
+	reply := fmt.Sprintf(tr(message.From.ID, "lng_chat_id"), message.Chat.ID)
+	_, _ = bot.ReplyMessage(message, reply, true, nil)
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"fmt"
+	"regexp"
 
 	"github.com/zhangpanyi/basebot/history"
 	"github.com/zhangpanyi/basebot/telegram/methods"
@@ -10,29 +11,59 @@ import (
 	"luckybot/app/logic/scriptengine"
 )
 
+// 匹配资产
+var reMathDepositAsset *regexp.Regexp
+
+func init() {
+	var err error
+	reMathDepositAsset, err = regexp.Compile("^/deposit/(\\w+)/$")
+	if err != nil {
+		panic(err)
+	}
+}
+
 // DepositHandler 存款
 type DepositHandler struct {
 }
 
 // Handle 消息处理
-func (*DepositHandler) Handle(bot *methods.BotExt, r *history.History, update *types.Update) {
+func (handler *DepositHandler) Handle(bot *methods.BotExt, r *history.History, update *types.Update) {
+	// 回复选择资产
+	query := update.CallbackQuery
+	fromID := query.From.ID
+	if query.Data == "/deposit/" {
+		reply := tr(fromID, "lng_deposit_choose_asset")
+		markup := makeAssetMenus(fromID, query.Data, "/main/")
+		_ = bot.AnswerCallbackQuery(query, "", false, "", 0)
+		_, _ = bot.EditMessageReplyMarkup(query.Message, reply, true, markup)
+		return
+	}
+
+	// 回复充值地址
+	result := reMathDepositAsset.FindStringSubmatch(query.Data)
+	if len(result) != 2 {
+		return
+	}
 	serveCfg := config.GetServe()
-	fromID := update.CallbackQuery.From.ID
-	address, memo := scriptengine.Engine.DepositAddress(fromID)
+	asset, ok := serveCfg.GetAsset(result[1])
+	if !ok {
+		return
+	}
+	address, memo := scriptengine.Engine().DepositAddress(fromID, asset.Symbol)
 	if len(memo) == 0 {
 		memo = tr(fromID, "lng_deposit_ignore")
 	}
-	reply := fmt.Sprintf(tr(fromID, "lng_deposit_say"), serveCfg.Name, serveCfg.Symbol,
-		address, memo, serveCfg.Precision)
+	reply := fmt.Sprintf(tr(fromID, "lng_deposit_say"), asset.Name, asset.Symbol,
+		address, memo, asset.Precision)
 	menus := [...]methods.InlineKeyboardButton{
 		methods.InlineKeyboardButton{
 			Text:         tr(fromID, "lng_back_superior"),
-			CallbackData: "/main/",
+			CallbackData: backSuperior(query.Data),
 		},
 	}
 	markup := methods.MakeInlineKeyboardMarkupAuto(menus[:], 1)
-	_ = bot.AnswerCallbackQuery(update.CallbackQuery, "", false, "", 0)
-	_, _ = bot.EditMessageReplyMarkup(update.CallbackQuery.Message, reply, true, markup)
+	_ = bot.AnswerCallbackQuery(query, "", false, "", 0)
+	_, _ = bot.EditMessageReplyMarkup(query.Message, reply, true, markup)
 }
 
 // 消息路由
@@ -2,9 +2,15 @@ package handlers
 
 import (
 	"fmt"
-	"math/big"
+	"time"
 
+	"github.com/zhangpanyi/basebot/history"
+	"github.com/zhangpanyi/basebot/logger"
+	"github.com/zhangpanyi/basebot/telegram/methods"
+	"github.com/zhangpanyi/basebot/telegram/types"
+	"luckybot/app/config"
 	"luckybot/app/fmath"
+	"luckybot/app/location"
 	"luckybot/app/logic/handlers/utils"
 	"luckybot/app/storage/models"
 )
@@ -14,19 +20,141 @@ func tr(userID int64, key string) string {
 	return utils.Tr(userID, key)
 }
 
-// 生成红包基本信息
-func makeBaseMessage(luckyMoney *models.LuckyMoney, received uint32) string {
-	tag := equalLuckyMoney
+// 格式化资产金额
+func formatAmount(symbol string, amount *fmath.Amount) string {
+	return utils.FormatAmount(symbol, amount)
+}
+
+// 获取红包类型
+func luckyMoneyType(luckyMoney *models.LuckyMoney) string {
+	if luckyMoney.RecipientID != 0 || len(luckyMoney.RecipientName) > 0 {
+		return exclusiveLuckyMoney
+	}
+	if len(luckyMoney.Password) > 0 {
+		return passwordLuckyMoney
+	}
+	if luckyMoney.Lucky {
+		return randLuckyMoney
+	}
+	return equalLuckyMoney
+}
+
+// 是否问答红包
+func isQuestionLuckyMoney(luckyMoney *models.LuckyMoney) bool {
+	return len(luckyMoney.Password) > 0 &&
+		models.NormalizePassword(luckyMoney.Message) != luckyMoney.Password
+}
+
+// 红包总金额, 普通红包为单个金额乘以个数
+func totalAmount(luckyMoney *models.LuckyMoney) *fmath.Amount {
 	if luckyMoney.Lucky {
-		tag = randLuckyMoney
+		return luckyMoney.Amount
 	}
+	return fmath.Mul(luckyMoney.Amount, int64(luckyMoney.Number))
+}
+
+// 红包是否已领完
+func isFinished(luckyMoney *models.LuckyMoney) bool {
+	return luckyMoney.Received.Cmp(totalAmount(luckyMoney)) >= 0
+}
+
+// 生成红包基本信息
+func makeBaseMessage(luckyMoney *models.LuckyMoney, received uint32) string {
+	tag := luckyMoneyType(luckyMoney)
 	message := tr(luckyMoney.SenderID, "lng_luckymoney_info")
 	typ := luckyMoneysTypeToString(luckyMoney.SenderID, tag)
-	amount := luckyMoney.Amount.String()
-	if !luckyMoney.Lucky {
-		amount = fmath.Mul(luckyMoney.Amount, big.NewFloat(float64(luckyMoney.Number))).String()
-	}
-	return fmt.Sprintf(message, luckyMoney.ID, typ, luckyMoney.Number-received, luckyMoney.Number,
+	amount := formatAmount(luckyMoney.Asset, totalAmount(luckyMoney))
+	message = fmt.Sprintf(message, luckyMoney.ID, typ, luckyMoney.Number-received, luckyMoney.Number,
 		luckyMoney.SenderName, luckyMoney.SenderID,
 		amount, luckyMoney.Asset, typ, luckyMoney.Message)
+
+	// 专属红包提示
+	if tag == exclusiveLuckyMoney {
+		recipient := recipientToString(luckyMoney.RecipientID, luckyMoney.RecipientName)
+		message += fmt.Sprintf(tr(luckyMoney.SenderID, "lng_luckymoney_exclusive_tip"), recipient)
+	}
+
+	// 定时红包提示
+	if luckyMoney.ActivateAt > time.Now().UTC().Unix() {
+		message += fmt.Sprintf(tr(luckyMoney.SenderID, "lng_luckymoney_activate_tip"),
+			location.Format(luckyMoney.ActivateAt))
+	}
+
+	// 口令红包提示
+	if isQuestionLuckyMoney(luckyMoney) {
+		message += tr(luckyMoney.SenderID, "lng_luckymoney_question_tip")
+	} else if len(luckyMoney.Password) > 0 {
+		message += tr(luckyMoney.SenderID, "lng_luckymoney_password_tip")
+	}
+	return message
+}
+
+// 格式化倒计时
+func formatCountdown(seconds int64) string {
+	if seconds < 0 {
+		seconds = 0
+	}
+	return fmt.Sprintf("%02d:%02d:%02d", seconds/3600, seconds%3600/60, seconds%60)
+}
+
+// 格式化有效期
+func formatExpire(fromID int64, seconds uint32) string {
+	if seconds%(24*3600) == 0 {
+		return fmt.Sprintf(tr(fromID, "lng_new_expire_days"), seconds/(24*3600))
+	}
+	if seconds%3600 == 0 {
+		return fmt.Sprintf(tr(fromID, "lng_new_expire_hours"), seconds/3600)
+	}
+	return fmt.Sprintf(tr(fromID, "lng_new_expire_minutes"), (seconds+59)/60)
+}
+
+// 生成资产菜单
+func makeAssetMenus(fromID int64, prefix, back string) *methods.InlineKeyboardMarkup {
+	serveCfg := config.GetServe()
+	menus := make([]methods.InlineKeyboardButton, 0, len(serveCfg.Assets))
+	for _, asset := range serveCfg.Assets {
+		menus = append(menus, methods.InlineKeyboardButton{
+			Text:         asset.Symbol,
+			CallbackData: prefix + asset.Symbol + "/",
+		})
+	}
+	markup := methods.MakeInlineKeyboardMarkupAuto(menus, 2)
+	backMenus := [...]methods.InlineKeyboardButton{
+		methods.InlineKeyboardButton{
+			Text:         tr(fromID, "lng_back_superior"),
+			CallbackData: back,
+		},
+	}
+	return markup.Merge(methods.MakeInlineKeyboardMarkupAuto(backMenus[:], 1))
+}
+
+// 获取账户冻结提示, 未冻结时返回false
+func accountFrozenReply(userID int64) (string, bool) {
+	model := models.AccountModel{}
+	frozen, reason, err := model.IsFrozen(userID)
+	if err != nil {
+		logger.Warnf("Failed to check account frozen, %v, %v", userID, err)
+		return "", false
+	}
+	if !frozen {
+		return "", false
+	}
+	return fmt.Sprintf(tr(userID, "lng_account_frozen"), reason), true
+}
+
+// 回复账户已冻结, 由消息触发时发送消息, 否则弹出提示
+func replyAccountFrozen(bot *methods.BotExt, r *history.History, update *types.Update) bool {
+	query := update.CallbackQuery
+	reply, frozen := accountFrozenReply(query.From.ID)
+	if !frozen {
+		return false
+	}
+
+	if back, err := r.Back(); err == nil && back.Message != nil {
+		_, _ = bot.SendMessage(query.From.ID, reply, false, nil)
+	} else {
+		_ = bot.AnswerCallbackQuery(query, reply, true, "", 0)
+	}
+	r.Clear()
+	return true
 }
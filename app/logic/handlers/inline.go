@@ -3,6 +3,7 @@ package handlers
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/zhangpanyi/basebot/telegram/methods"
 	"github.com/zhangpanyi/basebot/telegram/types"
@@ -18,6 +19,10 @@ func ShowLuckyMoney(bot *methods.BotExt, query *types.InlineQuery) {
 		replyLuckyMoneyList(bot, query)
 		return
 	}
+	if strings.HasPrefix(query.Query, "transfer ") {
+		replyTransferQuery(bot, query)
+		return
+	}
 	replyLuckyMoneyInfo(bot, query)
 }
 
@@ -48,7 +53,7 @@ func replyLuckyMoneyList(bot *methods.BotExt, query *types.InlineQuery) {
 	result := make([]methods.InlineQueryResult, 0)
 	for i := 0; i < len(ids); i++ {
 		luckyMoney, received, err := model.GetLuckyMoney(ids[i])
-		if err != nil || luckyMoney.Received == luckyMoney.Amount {
+		if err != nil || isFinished(luckyMoney) {
 			continue
 		}
 		result = append(result, makeLuckyMoneyInfo(luckyMoney, received, i))
@@ -80,7 +85,7 @@ func replyLuckyMoneyInfo(bot *methods.BotExt, query *types.InlineQuery) {
 		return
 	}
 	luckyMoney, received, err := model.GetLuckyMoney(id)
-	if err != nil || luckyMoney.Received == luckyMoney.Amount {
+	if err != nil || isFinished(luckyMoney) {
 		replyNone(bot, query)
 		return
 	}
@@ -93,11 +98,9 @@ func replyLuckyMoneyInfo(bot *methods.BotExt, query *types.InlineQuery) {
 
 // 生成红包信息
 func makeLuckyMoneyInfo(luckyMoney *models.LuckyMoney, received uint32, idx int) methods.InlineQueryResult {
-	tag := equalLuckyMoney
-	if luckyMoney.Lucky {
-		tag = randLuckyMoney
-	}
+	tag := luckyMoneyType(luckyMoney)
 	serveCfg := config.GetServe()
+	asset, _ := serveCfg.GetAsset(luckyMoney.Asset)
 	result := methods.InlineQueryResultArticle{}
 	result.ID = strconv.Itoa(idx)
 	result.Title = location.Format(luckyMoney.Timestamp)
@@ -119,19 +122,19 @@ func makeLuckyMoneyInfo(luckyMoney *models.LuckyMoney, received uint32, idx int)
 	}
 
 	// 生成红包缩略图
-	if len(serveCfg.ThumbURL) > 0 {
+	if asset != nil && len(asset.ThumbURL) > 0 {
 		result.ThumbWidth = 64
 		result.ThumbHeight = 64
-		result.ThumbURL = serveCfg.ThumbURL
+		result.ThumbURL = asset.ThumbURL
 	}
 
 	// 生成菜单项内容
 	reply := tr(luckyMoney.SenderID, "lng_luckymoney_item")
 	result.Description = fmt.Sprintf(reply,
 		luckyMoneysTypeToString(luckyMoney.SenderID, tag),
-		fmath.Sub(luckyMoney.Amount, luckyMoney.Received).String(),
-		luckyMoney.Amount.String(),
-		serveCfg.Symbol,
+		formatAmount(luckyMoney.Asset, fmath.Sub(totalAmount(luckyMoney), luckyMoney.Received)),
+		formatAmount(luckyMoney.Asset, totalAmount(luckyMoney)),
+		luckyMoney.Asset,
 		luckyMoney.Number-received,
 		luckyMoney.Number,
 	)
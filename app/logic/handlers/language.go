@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"regexp"
+
+	"github.com/zhangpanyi/basebot/history"
+	"github.com/zhangpanyi/basebot/logger"
+	"github.com/zhangpanyi/basebot/telegram/methods"
+	"github.com/zhangpanyi/basebot/telegram/types"
+	"luckybot/app/config"
+	"luckybot/app/logic/handlers/utils"
+	"luckybot/app/storage/models"
+)
+
+// 匹配语言
+var reMathLanguage *regexp.Regexp
+
+func init() {
+	var err error
+	reMathLanguage, err = regexp.Compile("^/language/(\\w+)/$")
+	if err != nil {
+		panic(err)
+	}
+}
+
+// LanguageHandler 语言设置
+type LanguageHandler struct {
+}
+
+// Handle 消息处理
+func (handler *LanguageHandler) Handle(bot *methods.BotExt, r *history.History, update *types.Update) {
+	query := update.CallbackQuery
+	fromID := query.From.ID
+	if query.Data == "/language/" {
+		handler.replyChooseLanguage(bot, query)
+		return
+	}
+
+	// 设置用户语言
+	result := reMathLanguage.FindStringSubmatch(query.Data)
+	if len(result) != 2 || !config.GetLanguge().Exist(result[1]) {
+		return
+	}
+	model := models.UserModel{}
+	if err := model.SetLanguage(fromID, result[1]); err != nil {
+		logger.Warnf("Failed to set user language, %v, %v", fromID, err)
+		return
+	}
+
+	// 返回主菜单
+	r.Clear()
+	_ = bot.AnswerCallbackQuery(query, tr(fromID, "lng_language_changed"), false, "", 0)
+	reply, menus := new(MainMenuHandler).replyMessage(fromID)
+	markup := methods.MakeInlineKeyboardMarkup(menus, 2, 2, 2, 2, 1)
+	_, _ = bot.EditMessageReplyMarkup(query.Message, reply, true, markup)
+}
+
+// 回复选择语言
+func (handler *LanguageHandler) replyChooseLanguage(bot *methods.BotExt, query *types.CallbackQuery) {
+	fromID := query.From.ID
+	current := utils.UserLanguage(fromID)
+	languages := config.GetLanguge()
+	codes := languages.Codes()
+	menus := make([]methods.InlineKeyboardButton, 0, len(codes))
+	for _, code := range codes {
+		name := languages.Value(code, "lng_language_name")
+		if code == current {
+			name = "✅ " + name
+		}
+		menus = append(menus, methods.InlineKeyboardButton{
+			Text:         name,
+			CallbackData: "/language/" + code + "/",
+		})
+	}
+	markup := methods.MakeInlineKeyboardMarkupAuto(menus, 2)
+	backMenus := [...]methods.InlineKeyboardButton{
+		methods.InlineKeyboardButton{
+			Text:         tr(fromID, "lng_back_superior"),
+			CallbackData: "/main/",
+		},
+	}
+	markup = markup.Merge(methods.MakeInlineKeyboardMarkupAuto(backMenus[:], 1))
+
+	_ = bot.AnswerCallbackQuery(query, "", false, "", 0)
+	_, _ = bot.EditMessageReplyMarkup(query.Message, tr(fromID, "lng_language_choose"), true, markup)
+}
+
+// 消息路由
+func (*LanguageHandler) route(bot *methods.BotExt, query *types.CallbackQuery) Handler {
+	return nil
+}
@@ -3,7 +3,6 @@ package handlers
 import (
 	"errors"
 	"fmt"
-	"math/big"
 	"strings"
 
 	"github.com/zhangpanyi/basebot/history"
@@ -11,6 +10,7 @@ import (
 	"github.com/zhangpanyi/basebot/telegram/methods"
 	"github.com/zhangpanyi/basebot/telegram/types"
 	"luckybot/app/config"
+	"luckybot/app/fmath"
 	"luckybot/app/storage"
 	"luckybot/app/storage/models"
 )
@@ -33,6 +33,12 @@ func (handler *MainMenuHandler) Handle(bot *methods.BotExt, r *history.History,
 
 	// 处理消息
 	if update.Message != nil {
+		// 回答红包问题
+		if strings.HasPrefix(update.Message.Text, "/start "+passwordStartPrefix) {
+			new(PasswordHandler).handleStart(bot, r, update.Message)
+			return
+		}
+
 		// 是否由子菜单处理
 		var callback *types.Update
 		r.Foreach(func(idx int, element *types.Update) bool {
@@ -57,7 +63,7 @@ func (handler *MainMenuHandler) Handle(bot *methods.BotExt, r *history.History,
 		// 发送菜单列表
 		r.Clear()
 		reply, menus := handler.replyMessage(update.Message.From.ID)
-		markup := methods.MakeInlineKeyboardMarkup(menus, 2, 2, 2, 1)
+		markup := methods.MakeInlineKeyboardMarkup(menus, 2, 2, 2, 2, 1)
 		_, _ = bot.SendMessage(update.Message.Chat.ID, reply, true, markup)
 		return
 	}
@@ -71,7 +77,7 @@ func (handler *MainMenuHandler) Handle(bot *methods.BotExt, r *history.History,
 		r.Clear()
 		_ = bot.AnswerCallbackQuery(update.CallbackQuery, "", false, "", 0)
 		reply, menus := handler.replyMessage(update.CallbackQuery.From.ID)
-		markup := methods.MakeInlineKeyboardMarkup(menus, 2, 2, 2, 1)
+		markup := methods.MakeInlineKeyboardMarkup(menus, 2, 2, 2, 2, 1)
 		_, _ = bot.EditMessageReplyMarkup(update.CallbackQuery.Message, reply, true, markup)
 		return
 	}
@@ -120,18 +126,38 @@ func (handler *MainMenuHandler) route(bot *methods.BotExt, query *types.Callback
 	if strings.HasPrefix(query.Data, "/withdraw/") {
 		return new(WithdrawHandler)
 	}
+
+	// 转账操作
+	if strings.HasPrefix(query.Data, "/transfer/") {
+		return new(TransferHandler)
+	}
+
+	// 语言设置
+	if strings.HasPrefix(query.Data, "/language/") {
+		return new(LanguageHandler)
+	}
+
+	// 定时开抢
+	if strings.HasPrefix(query.Data, "/schedule/") {
+		return new(ScheduleHandler)
+	}
+
+	// 回答红包问题
+	if strings.HasPrefix(query.Data, "/password/") {
+		return new(PasswordHandler)
+	}
 	return nil
 }
 
 // 获取用户资产数量
-func getUserBalance(userID int64, asset string) (*big.Float, *big.Float) {
+func getUserBalance(userID int64, asset string) (*fmath.Amount, *fmath.Amount) {
 	model := models.AccountModel{}
 	account, err := model.GetAccount(userID, asset)
 	if err != nil {
 		if !errors.Is(err, storage.ErrNoBucket) && !errors.Is(err, models.ErrNoSuchTypeAccount) {
 			logger.Warnf("Failed to get user asset, %v, %v, %v", userID, asset, err)
 		}
-		return big.NewFloat(0), big.NewFloat(0)
+		return fmath.Zero(), fmath.Zero()
 	}
 	return account.Amount, account.Locked
 }
@@ -140,13 +166,20 @@ func getUserBalance(userID int64, asset string) (*big.Float, *big.Float) {
 func (handler *MainMenuHandler) replyMessage(userID int64) (string, []methods.InlineKeyboardButton) {
 	// 获取资产信息
 	serveCfg := config.GetServe()
-	amount, locked := getUserBalance(userID, serveCfg.Symbol)
-	if serveCfg.Test && amount.Cmp(big.NewFloat(0)) == 0 {
-		model := models.AccountModel{}
-		account, err := model.Deposit(userID, serveCfg.Symbol, big.NewFloat(1000))
-		if err == nil {
-			amount, locked = account.Amount, account.Locked
+	balances := make([]string, 0, len(serveCfg.Assets))
+	for _, asset := range serveCfg.Assets {
+		amount, locked := getUserBalance(userID, asset.Symbol)
+		if serveCfg.Test && amount.Sign() == 0 {
+			model := models.AccountModel{}
+			testAmount, _ := fmath.Parse("1000", asset.Precision)
+			account, _, err := model.Deposit(userID, asset.Symbol, testAmount, nil)
+			if err == nil {
+				amount, locked = account.Amount, account.Locked
+			}
 		}
+		balance := fmt.Sprintf(tr(userID, "lng_welcome_asset"), asset.Name, asset.Symbol,
+			amount.Format(asset.Precision), asset.Symbol, locked.Format(asset.Precision), asset.Symbol)
+		balances = append(balances, balance)
 	}
 
 	// 生成菜单列表
@@ -155,11 +188,12 @@ func (handler *MainMenuHandler) replyMessage(userID int64) (string, []methods.In
 		methods.InlineKeyboardButton{Text: tr(userID, "lng_history"), CallbackData: "/history/"},
 		methods.InlineKeyboardButton{Text: tr(userID, "lng_deposit"), CallbackData: "/deposit/"},
 		methods.InlineKeyboardButton{Text: tr(userID, "lng_withdraw"), CallbackData: "/withdraw/"},
+		methods.InlineKeyboardButton{Text: tr(userID, "lng_transfer"), CallbackData: "/transfer/"},
 		methods.InlineKeyboardButton{Text: tr(userID, "lng_rate"), CallbackData: "/rate/"},
 		methods.InlineKeyboardButton{Text: tr(userID, "lng_share"), CallbackData: "/share/"},
 		methods.InlineKeyboardButton{Text: tr(userID, "lng_help"), CallbackData: "/usage/"},
+		methods.InlineKeyboardButton{Text: tr(userID, "lng_language"), CallbackData: "/language/"},
 	}
-	reply := fmt.Sprintf(tr(userID, "lng_welcome"), serveCfg.Name, serveCfg.Symbol,
-		amount.String(), serveCfg.Symbol, locked.String(), serveCfg.Symbol)
+	reply := fmt.Sprintf(tr(userID, "lng_welcome"), serveCfg.Name, strings.Join(balances, "\n\n"))
 	return reply, menus[:]
 }
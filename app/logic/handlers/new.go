@@ -1,8 +1,8 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
-	"math/big"
 	"regexp"
 	"strconv"
 	"strings"
@@ -15,10 +15,17 @@ import (
 	"luckybot/app/config"
 	"luckybot/app/fmath"
 	"luckybot/app/logic/algo"
+	"luckybot/app/logic/scriptengine"
 	"luckybot/app/monitor"
 	"luckybot/app/storage/models"
 )
 
+// 匹配资产
+var reMathAsset *regexp.Regexp
+
+// 匹配有效期
+var reMathExpire *regexp.Regexp
+
 // 匹配类型
 var reMathType *regexp.Regexp
 
@@ -28,19 +35,61 @@ var reMathAmount *regexp.Regexp
 // 匹配数量
 var reMathNumber *regexp.Regexp
 
+// 匹配范围
+var reMathScope *regexp.Regexp
+
+// 匹配群组
+var reMathChats *regexp.Regexp
+
+// 匹配用户
+var reMathRecipient *regexp.Regexp
+
+// 匹配用户名
+var reMathUserName *regexp.Regexp
+
 func init() {
 	var err error
-	reMathType, err = regexp.Compile("^/new/(rand|equal)/$")
+	reMathAsset, err = regexp.Compile("^/new/(\\w+)/$")
+	if err != nil {
+		panic(err)
+	}
+
+	reMathExpire, err = regexp.Compile("^/new/(\\w+)/(\\d+)/$")
+	if err != nil {
+		panic(err)
+	}
+
+	reMathType, err = regexp.Compile("^/new/(\\w+)/(\\d+)/(rand(?:-\\w+)?|equal|password(?:-\\w+)?|exclusive)/$")
+	if err != nil {
+		panic(err)
+	}
+
+	reMathAmount, err = regexp.Compile("^/new/(\\w+)/(\\d+)/(rand(?:-\\w+)?|equal|password(?:-\\w+)?|exclusive)/([0-9]+\\.?[0-9]*)/$")
+	if err != nil {
+		panic(err)
+	}
+
+	reMathNumber, err = regexp.Compile("^/new/(\\w+)/(\\d+)/(rand(?:-\\w+)?|equal|password(?:-\\w+)?|exclusive)/([0-9]+\\.?[0-9]*)/(\\d+)/$")
+	if err != nil {
+		panic(err)
+	}
+
+	reMathScope, err = regexp.Compile("^/new/(\\w+)/(\\d+)/(rand(?:-\\w+)?|equal|password(?:-\\w+)?)/([0-9]+\\.?[0-9]*)/(\\d+)/(any|chat|list)/$")
 	if err != nil {
 		panic(err)
 	}
 
-	reMathAmount, err = regexp.Compile("^/new/(rand|equal)/([0-9]+\\.?[0-9]*)/$")
+	reMathChats, err = regexp.Compile("^/new/(\\w+)/(\\d+)/(rand(?:-\\w+)?|equal|password(?:-\\w+)?)/([0-9]+\\.?[0-9]*)/(\\d+)/list/(-?\\d+(?:,-?\\d+)*)/$")
 	if err != nil {
 		panic(err)
 	}
 
-	reMathNumber, err = regexp.Compile("^/new/(rand|equal)/([0-9]+\\.?[0-9]*)/(\\d+)/$")
+	reMathRecipient, err = regexp.Compile("^/new/(\\w+)/(\\d+)/(exclusive)/([0-9]+\\.?[0-9]*)/(1)/(\\d+|@\\w+)/$")
+	if err != nil {
+		panic(err)
+	}
+
+	reMathUserName, err = regexp.Compile("^@([A-Za-z0-9_]{5,32})$")
 	if err != nil {
 		panic(err)
 	}
@@ -51,14 +100,38 @@ var (
 	randLuckyMoney = "rand"
 	// 普通红包
 	equalLuckyMoney = "equal"
+	// 口令红包
+	passwordLuckyMoney = "password"
+	// 专属红包
+	exclusiveLuckyMoney = "exclusive"
 )
 
+var (
+	// 所有人可领
+	scopeAnyone = "any"
+	// 仅限首发聊天
+	scopeFirstChat = "chat"
+	// 仅限指定群组
+	scopeWhitelist = "list"
+)
+
+// 最大群组数量
+const maxWhitelistChats = 10
+
 // 红包信息
 type luckyMoneys struct {
-	typ     string     // 红包类型
-	amount  *big.Float // 红包金额
-	number  int        // 红包个数
-	message string     // 红包留言
+	asset         string        // 资产类型
+	expire        uint32        // 有效期
+	typ           string        // 红包类型
+	distributor   string        // 分配算法
+	amount        *fmath.Amount // 红包金额
+	number        int           // 红包个数
+	scope         string        // 领取范围
+	chats         []int64       // 群组白名单
+	message       string        // 红包留言
+	password      string        // 红包口令
+	recipientID   int64         // 专属用户ID
+	recipientName string        // 专属用户名
 }
 
 // 红包类型转字符串
@@ -66,18 +139,203 @@ func luckyMoneysTypeToString(fromID int64, typ string) string {
 	if typ == randLuckyMoney {
 		return tr(fromID, "lng_new_rand")
 	}
+	if typ == passwordLuckyMoney {
+		return tr(fromID, "lng_new_password")
+	}
+	if typ == exclusiveLuckyMoney {
+		return tr(fromID, "lng_new_exclusive")
+	}
 	return tr(fromID, "lng_new_equal")
 }
 
+// 是否随机金额
+func isRandomType(typ string) bool {
+	return typ == randLuckyMoney || typ == passwordLuckyMoney
+}
+
+// 专属用户转字符串
+func recipientToString(recipientID int64, recipientName string) string {
+	if len(recipientName) > 0 {
+		return "@" + recipientName
+	}
+	return strconv.FormatInt(recipientID, 10)
+}
+
+// 解析专属用户, 支持用户ID、用户名和转发消息
+func parseRecipient(message *types.Message) (int64, string, bool) {
+	if message.ForwardFrom != nil {
+		return message.ForwardFrom.ID, "", true
+	}
+
+	text := strings.TrimSpace(message.Text)
+	if result := reMathUserName.FindStringSubmatch(text); len(result) == 2 {
+		return 0, result[1], true
+	}
+	recipientID, err := strconv.ParseInt(text, 10, 64)
+	if err != nil || recipientID <= 0 {
+		return 0, "", false
+	}
+	return recipientID, "", true
+}
+
+// 领取范围转字符串
+func luckyMoneysScopeToString(fromID int64, scope string, chats []int64) string {
+	switch scope {
+	case models.ScopeFirstChat:
+		return tr(fromID, "lng_new_scope_chat")
+	case models.ScopeWhitelist:
+		ids := make([]string, 0, len(chats))
+		for _, chatID := range chats {
+			ids = append(ids, strconv.FormatInt(chatID, 10))
+		}
+		return fmt.Sprintf("%s(%s)", tr(fromID, "lng_new_scope_list"), strings.Join(ids, ", "))
+	}
+	return tr(fromID, "lng_new_scope_anyone")
+}
+
+// 解析群组列表
+func parseChats(text string) ([]int64, bool) {
+	fields := strings.FieldsFunc(text, func(r rune) bool {
+		return r == ',' || r == '，' || r == ' ' || r == '\n'
+	})
+	if len(fields) == 0 || len(fields) > maxWhitelistChats {
+		return nil, false
+	}
+
+	chats := make([]int64, 0, len(fields))
+	exists := make(map[int64]bool)
+	for _, field := range fields {
+		chatID, err := strconv.ParseInt(field, 10, 64)
+		if err != nil || chatID == 0 {
+			return nil, false
+		}
+		if !exists[chatID] {
+			exists[chatID] = true
+			chats = append(chats, chatID)
+		}
+	}
+	return chats, true
+}
+
+// 解析红包口令, 单行为口令, 两行为问题和答案
+func parsePassword(text string) (string, string, bool) {
+	lines := make([]string, 0, 2)
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); len(line) > 0 {
+			lines = append(lines, line)
+		}
+	}
+
+	switch len(lines) {
+	case 1:
+		return lines[0], lines[0], true
+	case 2:
+		return lines[0], lines[1], true
+	}
+	return "", "", false
+}
+
+// 已启用的分配算法, 第一个为默认算法
+func enabledDistributors() []string {
+	serveCfg := config.GetServe()
+	names := make([]string, 0, len(serveCfg.Distributors))
+	for _, name := range serveCfg.Distributors {
+		if _, ok := algo.Get(name); ok {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		names = append(names, algo.DefaultDistributor)
+	}
+	return names
+}
+
+// 分配算法转字符串
+func distributorToString(fromID int64, name string) string {
+	return tr(fromID, "lng_distributor_"+name)
+}
+
+// 解析红包类型, 随机红包可以指定分配算法, 如 rand-jackpot
+func parseType(info *luckyMoneys, text string) bool {
+	s := strings.SplitN(text, "-", 2)
+	info.typ = s[0]
+	if !isRandomType(info.typ) {
+		return len(s) == 1
+	}
+
+	distributors := enabledDistributors()
+	info.distributor = distributors[0]
+	if len(s) == 1 {
+		return true
+	}
+	for _, name := range distributors {
+		if name == s[1] {
+			info.distributor = name
+			return true
+		}
+	}
+	return false
+}
+
+// 解析有效期
+func parseExpire(text string) (uint32, bool) {
+	expire, err := strconv.ParseUint(text, 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	serveCfg := config.GetServe()
+	if !serveCfg.IsValidExpire(uint32(expire)) {
+		return 0, false
+	}
+	return uint32(expire), true
+}
+
 // NewHandler 创建红包
 type NewHandler struct {
 }
 
 // Handle 消息处理
 func (handler *NewHandler) Handle(bot *methods.BotExt, r *history.History, update *types.Update) {
-	// 回复选择红包类型
+	// 检查账户冻结
+	if replyAccountFrozen(bot, r, update) {
+		return
+	}
+
+	// 回复选择资产类型
 	data := update.CallbackQuery.Data
 	if data == "/new/" {
+		r.Clear()
+		handler.replyChooseAsset(bot, update.CallbackQuery)
+		return
+	}
+
+	// 回复选择有效期
+	serveCfg := config.GetServe()
+	result := reMathAsset.FindStringSubmatch(data)
+	if len(result) == 2 {
+		if _, ok := serveCfg.GetAsset(result[1]); !ok {
+			return
+		}
+		r.Clear()
+		options := serveCfg.ExpireOptions()
+		if len(options) == 1 {
+			update.CallbackQuery.Data = data + strconv.FormatUint(uint64(options[0]), 10) + "/"
+			handler.replyChooseType(bot, update.CallbackQuery)
+			return
+		}
+		handler.replyChooseExpire(bot, update.CallbackQuery, options)
+		return
+	}
+
+	// 回复选择红包类型
+	result = reMathExpire.FindStringSubmatch(data)
+	if len(result) == 3 {
+		if _, ok := serveCfg.GetAsset(result[1]); !ok {
+			return
+		}
+		if _, ok := parseExpire(result[2]); !ok {
+			return
+		}
 		r.Clear()
 		handler.replyChooseType(bot, update.CallbackQuery)
 		return
@@ -85,36 +343,118 @@ func (handler *NewHandler) Handle(bot *methods.BotExt, r *history.History, updat
 
 	// 回复输入红包金额
 	info := luckyMoneys{}
-	result := reMathType.FindStringSubmatch(data)
-	if len(result) == 2 {
-		info.typ = result[1]
+	result = reMathType.FindStringSubmatch(data)
+	if len(result) == 4 {
+		info.asset = result[1]
+		if !parseType(&info, result[3]) {
+			return
+		}
+		if _, ok := serveCfg.GetAsset(info.asset); !ok {
+			return
+		}
+		expire, ok := parseExpire(result[2])
+		if !ok {
+			return
+		}
+		info.expire = expire
+		if isRandomType(result[3]) && len(enabledDistributors()) > 1 {
+			r.Clear()
+			handler.replyChooseDistributor(bot, update.CallbackQuery)
+			return
+		}
 		handler.replyEnterAmount(bot, r, &info, update)
 		return
 	}
 
 	// 回复输入红包数量
-	var ok bool
 	result = reMathAmount.FindStringSubmatch(data)
-	if len(result) == 3 {
-		info.typ = result[1]
-		info.amount, ok = big.NewFloat(0).SetString(result[2])
+	if len(result) == 5 {
+		info.asset = result[1]
+		if !parseType(&info, result[3]) {
+			return
+		}
+		asset, ok := serveCfg.GetAsset(info.asset)
 		if !ok {
 			return
 		}
+		expire, ok := parseExpire(result[2])
+		if !ok {
+			return
+		}
+		info.expire = expire
+		amount, err := fmath.Parse(result[4], asset.Precision)
+		if err != nil {
+			return
+		}
+		info.amount = amount
+		if info.typ == exclusiveLuckyMoney {
+			handler.replyEnterRecipient(bot, r, &info, update, true)
+			return
+		}
 		handler.replyEnterNumber(bot, r, &info, update, true)
 		return
 	}
 
-	// 回复输入红包留言
+	// 回复选择领取范围
 	result = reMathNumber.FindStringSubmatch(data)
-	if len(result) == 4 {
-		info.typ = result[1]
-		info.amount, ok = big.NewFloat(0).SetString(result[2])
+	if len(result) == 6 {
+		r.Clear()
+		if result[3] == exclusiveLuckyMoney {
+			if !handler.parseBaseInfo(&info, result) {
+				return
+			}
+			update.CallbackQuery.Data = backSuperior(data)
+			handler.replyEnterRecipient(bot, r, &info, update, true)
+			return
+		}
+		handler.replyChooseScope(bot, update.CallbackQuery, true)
+		return
+	}
+
+	// 回复输入群组或留言
+	result = reMathScope.FindStringSubmatch(data)
+	if len(result) == 7 {
+		if !handler.parseBaseInfo(&info, result) {
+			return
+		}
+		if result[6] == scopeWhitelist {
+			handler.replyEnterChats(bot, r, &info, update)
+			return
+		}
+		if result[6] == scopeFirstChat {
+			info.scope = models.ScopeFirstChat
+		}
+		handler.replyEnterMessage(bot, r, &info, update)
+		return
+	}
+
+	// 回复输入红包留言
+	result = reMathChats.FindStringSubmatch(data)
+	if len(result) == 7 {
+		if !handler.parseBaseInfo(&info, result) {
+			return
+		}
+		chats, ok := parseChats(result[6])
 		if !ok {
 			return
 		}
-		number, _ := strconv.Atoi(result[3])
-		info.number = number
+		info.scope = models.ScopeWhitelist
+		info.chats = chats
+		handler.replyEnterMessage(bot, r, &info, update)
+		return
+	}
+
+	// 回复输入专属红包留言
+	result = reMathRecipient.FindStringSubmatch(data)
+	if len(result) == 7 {
+		if !handler.parseBaseInfo(&info, result) {
+			return
+		}
+		if strings.HasPrefix(result[6], "@") {
+			info.recipientName = result[6][1:]
+		} else {
+			info.recipientID, _ = strconv.ParseInt(result[6], 10, 64)
+		}
 		handler.replyEnterMessage(bot, r, &info, update)
 		return
 	}
@@ -127,6 +467,31 @@ func (handler *NewHandler) Handle(bot *methods.BotExt, r *history.History, updat
 	newHandler.Handle(bot, r, update)
 }
 
+// 解析红包基本信息
+func (handler *NewHandler) parseBaseInfo(info *luckyMoneys, result []string) bool {
+	info.asset = result[1]
+	if !parseType(info, result[3]) {
+		return false
+	}
+	serveCfg := config.GetServe()
+	asset, ok := serveCfg.GetAsset(info.asset)
+	if !ok {
+		return false
+	}
+	expire, ok := parseExpire(result[2])
+	if !ok {
+		return false
+	}
+	info.expire = expire
+	amount, err := fmath.Parse(result[4], asset.Precision)
+	if err != nil {
+		return false
+	}
+	info.amount = amount
+	info.number, _ = strconv.Atoi(result[5])
+	return true
+}
+
 // 消息路由
 func (handler *NewHandler) route(bot *methods.BotExt, query *types.CallbackQuery) Handler {
 	return nil
@@ -156,12 +521,75 @@ func makeBaseMenus(fromID int64, data string) *methods.InlineKeyboardMarkup {
 	return methods.MakeInlineKeyboardMarkupAuto(menus[:], 1)
 }
 
+// 回复选择资产类型
+func (handler *NewHandler) replyChooseAsset(bot *methods.BotExt, query *types.CallbackQuery) {
+	fromID := query.From.ID
+	reply := tr(fromID, "lng_new_choose_asset")
+	markup := makeAssetMenus(fromID, query.Data, "/main/")
+	_ = bot.AnswerCallbackQuery(query, "", false, "", 0)
+	_, _ = bot.EditMessageReplyMarkup(query.Message, reply, true, markup)
+}
+
+// 回复选择有效期
+func (handler *NewHandler) replyChooseExpire(bot *methods.BotExt, query *types.CallbackQuery, options []uint32) {
+	// 生成菜单列表
+	data := query.Data
+	fromID := query.From.ID
+	menus := make([]methods.InlineKeyboardButton, 0, len(options)+1)
+	for _, expire := range options {
+		menus = append(menus, methods.InlineKeyboardButton{
+			Text:         formatExpire(fromID, expire),
+			CallbackData: data + strconv.FormatUint(uint64(expire), 10) + "/",
+		})
+	}
+	menus = append(menus, methods.InlineKeyboardButton{
+		Text:         tr(fromID, "lng_back_superior"),
+		CallbackData: backSuperior(data),
+	})
+
+	// 回复请求结果
+	reply := tr(fromID, "lng_new_choose_expire")
+	markup := methods.MakeInlineKeyboardMarkupAuto(menus[:], 2)
+	_ = bot.AnswerCallbackQuery(query, "", false, "", 0)
+	_, _ = bot.EditMessageReplyMarkup(query.Message, reply, true, markup)
+}
+
+// 回复选择分配算法
+func (handler *NewHandler) replyChooseDistributor(bot *methods.BotExt, query *types.CallbackQuery) {
+	// 生成菜单列表
+	data := query.Data
+	fromID := query.From.ID
+	distributors := enabledDistributors()
+	menus := make([]methods.InlineKeyboardButton, 0, len(distributors)+1)
+	for _, name := range distributors {
+		menus = append(menus, methods.InlineKeyboardButton{
+			Text:         distributorToString(fromID, name),
+			CallbackData: data[:len(data)-1] + "-" + name + "/",
+		})
+	}
+	menus = append(menus, methods.InlineKeyboardButton{
+		Text:         tr(fromID, "lng_back_superior"),
+		CallbackData: backSuperior(data),
+	})
+
+	// 回复请求结果
+	reply := tr(fromID, "lng_new_choose_distributor")
+	markup := methods.MakeInlineKeyboardMarkupAuto(menus[:], 2)
+	_ = bot.AnswerCallbackQuery(query, "", false, "", 0)
+	_, _ = bot.EditMessageReplyMarkup(query.Message, reply, true, markup)
+}
+
 // 回复输入选择类型
 func (handler *NewHandler) replyChooseType(bot *methods.BotExt, query *types.CallbackQuery) {
 
 	// 生成菜单列表
 	data := query.Data
 	fromID := query.From.ID
+	back := backSuperior(data)
+	serveCfg := config.GetServe()
+	if len(serveCfg.ExpireOptions()) == 1 {
+		back = backSuperior(back)
+	}
 	menus := [...]methods.InlineKeyboardButton{
 		methods.InlineKeyboardButton{
 			Text:         tr(fromID, "lng_new_rand"),
@@ -171,15 +599,23 @@ func (handler *NewHandler) replyChooseType(bot *methods.BotExt, query *types.Cal
 			Text:         tr(fromID, "lng_new_equal"),
 			CallbackData: data + equalLuckyMoney + "/",
 		},
+		methods.InlineKeyboardButton{
+			Text:         tr(fromID, "lng_new_password"),
+			CallbackData: data + passwordLuckyMoney + "/",
+		},
+		methods.InlineKeyboardButton{
+			Text:         tr(fromID, "lng_new_exclusive"),
+			CallbackData: data + exclusiveLuckyMoney + "/",
+		},
 		methods.InlineKeyboardButton{
 			Text:         tr(fromID, "lng_back_superior"),
-			CallbackData: "/main/",
+			CallbackData: back,
 		},
 	}
 
 	// 回复请求结果
 	reply := tr(fromID, "lng_new_choose_type")
-	markup := methods.MakeInlineKeyboardMarkup(menus[:], 2, 1)
+	markup := methods.MakeInlineKeyboardMarkup(menus[:], 2, 2, 1)
 	_ = bot.AnswerCallbackQuery(query, "", false, "", 0)
 	_, _ = bot.EditMessageReplyMarkup(query.Message, reply, true, markup)
 }
@@ -203,24 +639,18 @@ func (handler *NewHandler) handleEnterAmount(bot *methods.BotExt, r *history.His
 
 	// 检查输入金额
 	serveCfg := config.GetServe()
-	amount, ok := big.NewFloat(0).SetString(enterAmount)
-	if !ok || amount.Cmp(big.NewFloat(0)) <= 0 {
-		handlerError(fmt.Sprintf(tr(fromID, "lng_new_set_amount_error"), serveCfg.Precision))
-		return
-	}
-
-	// 检查小数点位数
-	s := strings.Split(enterAmount, ".")
-	if len(s) == 2 && len(s[1]) > serveCfg.Precision {
-		handlerError(fmt.Sprintf(tr(fromID, "lng_new_set_amount_error"), serveCfg.Precision))
+	asset, _ := serveCfg.GetAsset(info.asset)
+	amount, err := fmath.Parse(enterAmount, asset.Precision)
+	if err != nil || amount.Sign() <= 0 {
+		handlerError(fmt.Sprintf(tr(fromID, "lng_new_set_amount_error"), asset.Precision))
 		return
 	}
 
 	// 检查帐户余额
-	balance, _ := getUserBalance(fromID, serveCfg.Symbol)
+	balance, _ := getUserBalance(fromID, asset.Symbol)
 	if amount.Cmp(balance) == 1 {
 		reply := tr(fromID, "lng_new_set_amount_no_asset")
-		handlerError(fmt.Sprintf(reply, serveCfg.Symbol, balance))
+		handlerError(fmt.Sprintf(reply, asset.Symbol, balance))
 		return
 	}
 
@@ -228,6 +658,10 @@ func (handler *NewHandler) handleEnterAmount(bot *methods.BotExt, r *history.His
 	r.Clear()
 	info.amount = amount
 	update.CallbackQuery.Data = data + enterAmount + "/"
+	if info.typ == exclusiveLuckyMoney {
+		handler.replyEnterRecipient(bot, r, info, update, false)
+		return
+	}
 	handler.replyEnterNumber(bot, r, info, update, false)
 }
 
@@ -255,23 +689,20 @@ func (handler *NewHandler) replyEnterAmount(bot *methods.BotExt, r *history.Hist
 	}
 
 	serveCfg := config.GetServe()
-	answer := fmt.Sprintf(tr(fromID, "lng_new_set_amount_answer"), amountDesc, serveCfg.Precision)
+	asset, _ := serveCfg.GetAsset(info.asset)
+	answer := fmt.Sprintf(tr(fromID, "lng_new_set_amount_answer"), amountDesc, asset.Precision)
 	_ = bot.AnswerCallbackQuery(query, answer, false, "", 0)
 
 	reply := tr(fromID, "lng_new_set_amount")
-	amount, _ := getUserBalance(fromID, serveCfg.Symbol)
-	reply = fmt.Sprintf(reply, amountDesc, serveCfg.Precision, luckyMoneysTypeToString(fromID, info.typ),
-		serveCfg.Symbol, amount.String())
+	amount, _ := getUserBalance(fromID, asset.Symbol)
+	reply = fmt.Sprintf(reply, amountDesc, asset.Precision, luckyMoneysTypeToString(fromID, info.typ),
+		asset.Symbol, amount.Format(asset.Precision))
 	_, _ = bot.EditMessageReplyMarkup(query.Message, reply, true, markup)
 }
 
 // 最低单个金额
-func minSingleAmount() *big.Float {
-	base := big.NewInt(10)
-	serveCfg := config.GetServe()
-	base.Exp(base, big.NewInt(int64(serveCfg.Precision)), nil)
-	wei, _ := big.NewFloat(0).SetString(base.String())
-	return wei.Quo(big.NewFloat(1), wei)
+func minSingleAmount(asset *config.Asset) *fmath.Amount {
+	return fmath.NewAmount(1)
 }
 
 // 处理输入红包个数
@@ -292,29 +723,25 @@ func (handler *NewHandler) handleEnterNumber(bot *methods.BotExt, r *history.His
 
 	// 检查红包数量
 	serveCfg := config.GetServe()
+	asset, _ := serveCfg.GetAsset(info.asset)
 	number, err := strconv.Atoi(enterNumber)
 	if err != nil || number <= 0 {
-		handlerError(fmt.Sprintf(tr(fromID, "lng_new_set_number_error"), minSingleAmount().String()))
+		handlerError(fmt.Sprintf(tr(fromID, "lng_new_set_number_error"), minSingleAmount(asset).Format(asset.Precision)))
 		return
 	}
 
 	// 检查账户余额
-	balance, _ := getUserBalance(fromID, serveCfg.Symbol)
+	balance, _ := getUserBalance(fromID, asset.Symbol)
 	if info.typ == equalLuckyMoney {
-		if fmath.Mul(info.amount, big.NewFloat(float64(number))).Cmp(balance) == 1 {
+		if fmath.Mul(info.amount, int64(number)).Cmp(balance) == 1 {
 			reply := tr(fromID, "lng_new_set_number_not_enough")
-			handlerError(fmt.Sprintf(reply, serveCfg.Symbol, balance.String()))
+			handlerError(fmt.Sprintf(reply, asset.Symbol, balance.Format(asset.Precision)))
 			return
 		}
-	} else if info.typ == randLuckyMoney {
-		base := big.NewInt(10)
-		base.Exp(base, big.NewInt(int64(serveCfg.Precision)), nil)
-		wei, _ := big.NewFloat(0).SetString(base.String())
-		product := fmath.Mul(wei, info.amount)
-		unit, _ := product.Int(big.NewInt(0))
-		if unit.Cmp(big.NewInt(int64(number))) == -1 {
+	} else {
+		if info.amount.Cmp(fmath.NewAmount(int64(number))) == -1 {
 			reply := tr(fromID, "lng_new_set_number_not_enough")
-			handlerError(fmt.Sprintf(reply, serveCfg.Symbol, balance.String()))
+			handlerError(fmt.Sprintf(reply, asset.Symbol, balance.Format(asset.Precision)))
 			return
 		}
 	}
@@ -323,9 +750,157 @@ func (handler *NewHandler) handleEnterNumber(bot *methods.BotExt, r *history.His
 	r.Clear()
 	info.number = number
 	update.CallbackQuery.Data += enterNumber + "/"
+	handler.replyChooseScope(bot, update.CallbackQuery, false)
+}
+
+// 处理输入专属用户
+func (handler *NewHandler) handleEnterRecipient(bot *methods.BotExt, r *history.History,
+	info *luckyMoneys, update *types.Update, message *types.Message) {
+
+	// 处理错误
+	query := update.CallbackQuery
+	fromID := query.From.ID
+	handlerError := func(reply string) {
+		r.Pop()
+		markup := makeBaseMenus(fromID, query.Data)
+		_ = bot.AnswerCallbackQuery(query, "", false, "", 0)
+		_, _ = bot.SendMessage(fromID, reply, true, markup)
+	}
+
+	// 检查专属用户
+	recipientID, recipientName, ok := parseRecipient(message)
+	if !ok {
+		handlerError(tr(fromID, "lng_new_set_recipient_error"))
+		return
+	}
+	if recipientID == fromID {
+		handlerError(tr(fromID, "lng_new_set_recipient_self"))
+		return
+	}
+
+	// 更新下个操作状态
+	r.Clear()
+	info.number = 1
+	info.recipientID = recipientID
+	info.recipientName = recipientName
+	update.CallbackQuery.Data += "1/" + recipientToString(recipientID, recipientName) + "/"
+	handler.replyEnterMessage(bot, r, info, update)
+}
+
+// 回复输入专属用户
+func (handler *NewHandler) replyEnterRecipient(bot *methods.BotExt, r *history.History, info *luckyMoneys,
+	update *types.Update, edit bool) {
+
+	// 处理输入用户
+	back, err := r.Back()
+	if err == nil && back.Message != nil {
+		handler.handleEnterRecipient(bot, r, info, update, back.Message)
+		return
+	}
+
+	// 提示输入专属用户
+	r.Clear().Push(update)
+	query := update.CallbackQuery
+	fromID := query.From.ID
+	markup := makeBaseMenus(fromID, query.Data)
+	reply := fmt.Sprintf(tr(fromID, "lng_new_set_recipient"), formatAmount(info.asset, info.amount), info.asset)
+	if !edit {
+		_, _ = bot.SendMessage(fromID, reply, true, markup)
+	} else {
+		_, _ = bot.EditMessageReplyMarkup(query.Message, reply, true, markup)
+	}
+	_ = bot.AnswerCallbackQuery(query, tr(fromID, "lng_new_set_recipient_answer"), false, "", 0)
+}
+
+// 回复选择领取范围
+func (handler *NewHandler) replyChooseScope(bot *methods.BotExt, query *types.CallbackQuery, edit bool) {
+	// 生成菜单列表
+	data := query.Data
+	fromID := query.From.ID
+	menus := [...]methods.InlineKeyboardButton{
+		methods.InlineKeyboardButton{
+			Text:         tr(fromID, "lng_new_scope_anyone"),
+			CallbackData: data + scopeAnyone + "/",
+		},
+		methods.InlineKeyboardButton{
+			Text:         tr(fromID, "lng_new_scope_chat"),
+			CallbackData: data + scopeFirstChat + "/",
+		},
+		methods.InlineKeyboardButton{
+			Text:         tr(fromID, "lng_new_scope_list"),
+			CallbackData: data + scopeWhitelist + "/",
+		},
+		methods.InlineKeyboardButton{
+			Text:         tr(fromID, "lng_back_superior"),
+			CallbackData: backSuperior(data),
+		},
+	}
+
+	// 回复请求结果
+	reply := tr(fromID, "lng_new_choose_scope")
+	markup := methods.MakeInlineKeyboardMarkup(menus[:], 1, 2, 1)
+	_ = bot.AnswerCallbackQuery(query, "", false, "", 0)
+	if !edit {
+		_, _ = bot.SendMessage(fromID, reply, true, markup)
+	} else {
+		_, _ = bot.EditMessageReplyMarkup(query.Message, reply, true, markup)
+	}
+}
+
+// 处理输入群组列表
+func (handler *NewHandler) handleEnterChats(bot *methods.BotExt, r *history.History,
+	info *luckyMoneys, update *types.Update, enterChats string) {
+
+	// 处理错误
+	query := update.CallbackQuery
+	fromID := query.From.ID
+	handlerError := func(reply string) {
+		r.Pop()
+		markup := makeBaseMenus(fromID, query.Data)
+		_ = bot.AnswerCallbackQuery(query, "", false, "", 0)
+		_, _ = bot.SendMessage(fromID, reply, true, markup)
+	}
+
+	// 检查群组列表
+	chats, ok := parseChats(enterChats)
+	if !ok {
+		handlerError(fmt.Sprintf(tr(fromID, "lng_new_set_chats_error"), maxWhitelistChats))
+		return
+	}
+
+	// 更新下个操作状态
+	r.Clear()
+	ids := make([]string, 0, len(chats))
+	for _, chatID := range chats {
+		ids = append(ids, strconv.FormatInt(chatID, 10))
+	}
+	info.scope = models.ScopeWhitelist
+	info.chats = chats
+	update.CallbackQuery.Data += strings.Join(ids, ",") + "/"
 	handler.replyEnterMessage(bot, r, info, update)
 }
 
+// 回复输入群组列表
+func (handler *NewHandler) replyEnterChats(bot *methods.BotExt, r *history.History, info *luckyMoneys,
+	update *types.Update) {
+
+	// 处理输入群组
+	back, err := r.Back()
+	if err == nil && back.Message != nil {
+		handler.handleEnterChats(bot, r, info, update, back.Message.Text)
+		return
+	}
+
+	// 提示输入群组列表
+	r.Clear().Push(update)
+	query := update.CallbackQuery
+	fromID := query.From.ID
+	markup := makeBaseMenus(fromID, query.Data)
+	reply := fmt.Sprintf(tr(fromID, "lng_new_set_chats"), maxWhitelistChats, bot.UserName)
+	_ = bot.AnswerCallbackQuery(query, tr(fromID, "lng_new_set_chats_answer"), false, "", 0)
+	_, _ = bot.EditMessageReplyMarkup(query.Message, reply, true, markup)
+}
+
 // 回复输入红包数量
 func (handler *NewHandler) replyEnterNumber(bot *methods.BotExt, r *history.History, info *luckyMoneys,
 	update *types.Update, edit bool) {
@@ -349,9 +924,10 @@ func (handler *NewHandler) replyEnterNumber(bot *methods.BotExt, r *history.Hist
 	}
 
 	serveCfg := config.GetServe()
+	asset, _ := serveCfg.GetAsset(info.asset)
 	reply := tr(fromID, "lng_new_set_number")
-	reply = fmt.Sprintf(reply, minSingleAmount().String(), luckyMoneysTypeToString(fromID, info.typ),
-		amountDesc, info.amount.String(), serveCfg.Symbol)
+	reply = fmt.Sprintf(reply, minSingleAmount(asset).Format(asset.Precision), luckyMoneysTypeToString(fromID, info.typ),
+		amountDesc, info.amount.Format(asset.Precision), asset.Symbol)
 
 	if !edit {
 		_, _ = bot.SendMessage(fromID, reply, true, markup)
@@ -385,8 +961,19 @@ func (handler *NewHandler) handleEnterMessage(bot *methods.BotExt, r *history.Hi
 		return
 	}
 
-	// 处理生成红包
+	// 检查红包口令
 	info.message = message
+	if info.typ == passwordLuckyMoney {
+		question, answer, ok := parsePassword(message)
+		if !ok {
+			handlerError(tr(fromID, "lng_new_set_password_error"))
+			return
+		}
+		info.message = question
+		info.password = models.NormalizePassword(answer)
+	}
+
+	// 处理生成红包
 	data, err := handler.handleGenerateLuckyMoney(fromID, query.From.FirstName, info)
 	if err != nil {
 		logger.Warnf("Failed to create lucky money, %v", err)
@@ -409,6 +996,10 @@ func (handler *NewHandler) handleEnterMessage(bot *methods.BotExt, r *history.Hi
 			Text:              tr(fromID, "lng_send_luckymoney"),
 			SwitchInlineQuery: data.SN,
 		},
+		methods.InlineKeyboardButton{
+			Text:         tr(fromID, "lng_new_schedule"),
+			CallbackData: "/schedule/" + data.SN + "/",
+		},
 	}
 	_ = bot.AnswerCallbackQuery(query, "", false, "", 0)
 	markup := methods.MakeInlineKeyboardMarkupAuto(menus[:], 1)
@@ -443,12 +1034,23 @@ func (handler *NewHandler) replyEnterMessage(bot *methods.BotExt, r *history.His
 	if info.typ == equalLuckyMoney {
 		amount = tr(fromID, "lng_new_unit_amount")
 	}
-	serveCfg := config.GetServe()
-	reply := tr(fromID, "lng_new_set_message")
-	reply = fmt.Sprintf(reply, luckyMoneysTypeToString(fromID, info.typ), serveCfg.Symbol,
-		amount, info.amount.String(), serveCfg.Symbol, info.number)
+	reply, answer := tr(fromID, "lng_new_set_message"), tr(fromID, "lng_new_set_message_answer")
+	if info.typ == passwordLuckyMoney {
+		reply, answer = tr(fromID, "lng_new_set_password"), tr(fromID, "lng_new_set_password_answer")
+	}
+	scope := luckyMoneysScopeToString(fromID, info.scope, info.chats)
+	if info.typ == exclusiveLuckyMoney {
+		scope = fmt.Sprintf(tr(fromID, "lng_new_scope_exclusive"), recipientToString(info.recipientID, info.recipientName))
+	}
+	typ := luckyMoneysTypeToString(fromID, info.typ)
+	if isRandomType(info.typ) && len(enabledDistributors()) > 1 {
+		typ = fmt.Sprintf(tr(fromID, "lng_new_type_distributor"), typ, distributorToString(fromID, info.distributor))
+	}
+	reply = fmt.Sprintf(reply, typ, info.asset,
+		amount, formatAmount(info.asset, info.amount), info.asset, info.number, scope,
+		formatExpire(fromID, info.expire))
 	_, _ = bot.SendMessage(fromID, reply, true, markup)
-	_ = bot.AnswerCallbackQuery(query, tr(fromID, "lng_new_set_message_answer"), false, "", 0)
+	_ = bot.AnswerCallbackQuery(query, answer, false, "", 0)
 }
 
 // 处理生成红包
@@ -456,76 +1058,68 @@ func (handler *NewHandler) handleGenerateLuckyMoney(userID int64, firstName stri
 	info *luckyMoneys) (*models.LuckyMoney, error) {
 
 	// 生成红包
-	var luckyMoneyArr []*big.Float
-	amount := big.NewFloat(0).Set(info.amount)
+	var luckyMoneyArr []*fmath.Amount
+	amount := fmath.Zero().Set(info.amount)
 	if info.typ == equalLuckyMoney {
-		amount.Mul(amount, big.NewFloat(float64(info.number)))
+		amount.Mul(amount, int64(info.number))
+	}
+	serveCfg := config.GetServe()
+	asset, ok := serveCfg.GetAsset(info.asset)
+	if !ok {
+		return nil, errors.New("invalid asset")
 	}
-	if info.typ == randLuckyMoney {
+	if isRandomType(info.typ) {
 		var err error
-		serveCfg := config.GetServe()
-		luckyMoneyArr, err = algo.Generate(amount, serveCfg.Precision, info.number)
+		luckyMoneyArr, err = algo.GenerateWith(info.distributor, amount, info.number)
 		if err != nil {
 			logger.Errorf("Failed to generate lucky money, user_id: %v, %v", userID, err)
 			return nil, err
 		}
 	} else {
-		luckyMoneyArr = make([]*big.Float, 0, info.number)
+		luckyMoneyArr = make([]*fmath.Amount, 0, info.number)
 		for i := 0; i < info.number; i++ {
 			luckyMoneyArr = append(luckyMoneyArr, info.amount)
 		}
 	}
 
-	// 锁定资金
-	serveCfg := config.GetServe()
-	model := models.AccountModel{}
-	account, err := model.LockAccount(userID, serveCfg.Symbol, amount)
-	if err != nil {
-		return nil, err
-	}
-	logger.Errorf("Lock account, user_id: %v, asset: %v, amount: %v",
-		userID, serveCfg.Symbol, amount.String())
-
 	// 保存红包信息
+	now := time.Now().UTC().Unix()
 	luckyMoney := models.LuckyMoney{
-		SenderID:   userID,
-		SenderName: firstName,
-		Asset:      serveCfg.Symbol,
-		Amount:     info.amount,
-		Number:     uint32(info.number),
-		Message:    info.message,
-		Lucky:      info.typ == randLuckyMoney,
-		Timestamp:  time.Now().UTC().Unix(),
+		SenderID:      userID,
+		SenderName:    firstName,
+		Asset:         asset.Symbol,
+		Amount:        info.amount,
+		Number:        uint32(info.number),
+		Message:       info.message,
+		Lucky:         isRandomType(info.typ),
+		Distributor:   info.distributor,
+		Timestamp:     now,
+		Scope:         info.scope,
+		Chats:         info.chats,
+		Password:      info.password,
+		RecipientID:   info.recipientID,
+		RecipientName: info.recipientName,
+		ExpireAt:      now + int64(info.expire),
 	}
-	if info.typ == equalLuckyMoney {
-		luckyMoney.Value = big.NewFloat(0).Set(info.amount)
+	if !isRandomType(info.typ) {
+		luckyMoney.Value = fmath.Zero().Set(info.amount)
 	}
 	luckyMoneyModel := models.LuckyMoneyModel{}
 	data, err := luckyMoneyModel.NewLuckyMoney(&luckyMoney, luckyMoneyArr)
 	if err != nil {
-		// 解锁资金
-		if _, err := model.UnlockAccount(userID, serveCfg.Symbol, amount); err != nil {
-			logger.Errorf("Failed to unlock asset, user_id: %v, asset: %v, amount: %v",
-				userID, serveCfg.Symbol, amount.String())
-		}
 		logger.Errorf("Failed to new lucky money, user_id: %v, %v", userID, err)
 		return nil, err
 	}
 	logger.Errorf("Generate lucky money, id: %v, user_id: %v, asset: %v, amount: %v",
-		data.ID, userID, serveCfg.Symbol, amount.String())
-
-	// 插入账户记录
-	versionModel := models.AccountVersionModel{}
-	_, _ = versionModel.InsertVersion(userID, &models.Version{
-		Symbol:          serveCfg.Symbol,
-		Locked:          amount,
-		Amount:          account.Amount,
-		Reason:          models.ReasonGive,
-		RefLuckyMoneyID: &luckyMoney.ID,
-	})
+		data.ID, userID, asset.Symbol, amount.Format(asset.Precision))
 
 	// 添加到检查队列
-	monitor.AddToQueue(luckyMoney.ID, luckyMoney.Timestamp)
+	monitor.AddToQueue(data)
+
+	// 通知脚本引擎
+	if err = scriptengine.Engine().OnLuckyMoneyCreated(scriptengine.LuckyMoneyEvent(data)); err != nil {
+		logger.Warnf("Failed to call on_lucky_money_created, id: %v, %v", data.ID, err)
+	}
 
 	return data, nil
 }
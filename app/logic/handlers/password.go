@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/zhangpanyi/basebot/history"
+	"github.com/zhangpanyi/basebot/logger"
+	"github.com/zhangpanyi/basebot/telegram/methods"
+	"github.com/zhangpanyi/basebot/telegram/types"
+	"luckybot/app/storage/models"
+)
+
+// 口令链接前缀
+const passwordStartPrefix = "pw_"
+
+// 匹配口令
+var reMathPassword *regexp.Regexp
+
+func init() {
+	var err error
+	reMathPassword, err = regexp.Compile("^/password/(\\w+)/$")
+	if err != nil {
+		panic(err)
+	}
+}
+
+// 生成口令链接
+func makePasswordStartURL(bot *methods.BotExt, luckyMoney *models.LuckyMoney) string {
+	return fmt.Sprintf("https://t.me/%s?start=%s%s", bot.UserName, passwordStartPrefix, luckyMoney.SN)
+}
+
+// PasswordHandler 回答红包问题
+type PasswordHandler struct {
+}
+
+// Handle 消息处理
+func (handler *PasswordHandler) Handle(bot *methods.BotExt, r *history.History, update *types.Update) {
+	// 获取回答内容
+	back, err := r.Back()
+	if err != nil || back.Message == nil {
+		return
+	}
+	query := update.CallbackQuery
+	fromID := query.From.ID
+	result := reMathPassword.FindStringSubmatch(query.Data)
+	if len(result) != 2 {
+		r.Clear()
+		return
+	}
+
+	// 获取红包ID
+	model := models.LuckyMoneyModel{}
+	id, err := model.GetLuckyMoneyIDBySN(result[1])
+	if err != nil {
+		r.Clear()
+		_, _ = bot.SendMessage(fromID, tr(fromID, "lng_chat_invalid_id"), false, nil)
+		return
+	}
+
+	// 验证问题答案
+	err = model.UnlockLuckyMoney(id, fromID, back.Message.Text)
+	if err != nil {
+		if errors.Is(err, models.ErrWrongPassword) {
+			r.Pop()
+			_, _ = bot.SendMessage(fromID, tr(fromID, "lng_password_wrong"), true, nil)
+			return
+		}
+
+		r.Clear()
+		if errors.Is(err, models.ErrLuckyMoneydExpired) {
+			_, _ = bot.SendMessage(fromID, tr(fromID, "lng_chat_expired_say"), false, nil)
+			return
+		}
+		logger.Warnf("Failed to unlock lucky money, id: %d, user_id: %d, %v", id, fromID, err)
+		_, _ = bot.SendMessage(fromID, tr(fromID, "lng_chat_receive_error"), false, nil)
+		return
+	}
+
+	r.Clear()
+	_, _ = bot.SendMessage(fromID, tr(fromID, "lng_password_correct"), true, nil)
+}
+
+// 处理口令链接
+func (handler *PasswordHandler) handleStart(bot *methods.BotExt, r *history.History, message *types.Message) {
+	// 获取红包信息
+	r.Clear()
+	fromID := message.From.ID
+	sn := strings.TrimPrefix(message.Text, "/start "+passwordStartPrefix)
+	model := models.LuckyMoneyModel{}
+	id, err := model.GetLuckyMoneyIDBySN(sn)
+	if err != nil {
+		_, _ = bot.SendMessage(fromID, tr(fromID, "lng_chat_invalid_id"), false, nil)
+		return
+	}
+	luckyMoney, _, err := model.GetLuckyMoney(id)
+	if err != nil || len(luckyMoney.Password) == 0 {
+		_, _ = bot.SendMessage(fromID, tr(fromID, "lng_chat_invalid_id"), false, nil)
+		return
+	}
+
+	// 检查红包状态
+	if model.IsExpired(id) {
+		_, _ = bot.SendMessage(fromID, tr(fromID, "lng_chat_expired_say"), false, nil)
+		return
+	}
+	if received, _ := model.IsReceived(id, fromID); received {
+		_, _ = bot.SendMessage(fromID, tr(fromID, "lng_chat_repeat_receive"), false, nil)
+		return
+	}
+
+	// 提示回答问题
+	menus := [...]methods.InlineKeyboardButton{
+		methods.InlineKeyboardButton{
+			Text:         tr(fromID, "lng_back_menu"),
+			CallbackData: "/main/",
+		},
+	}
+	markup := methods.MakeInlineKeyboardMarkupAuto(menus[:], 1)
+	reply := fmt.Sprintf(tr(fromID, "lng_password_question"), luckyMoney.ID, luckyMoney.Message)
+	sent, err := bot.SendMessage(fromID, reply, true, markup)
+	if err != nil {
+		return
+	}
+
+	// 等待回答问题
+	r.Push(&types.Update{
+		CallbackQuery: &types.CallbackQuery{
+			From: &types.Chat{
+				ID:        fromID,
+				Type:      types.ChatPrivate,
+				FirstName: message.From.FirstName,
+			},
+			Message: sent,
+			Data:    "/password/" + sn + "/",
+		},
+	})
+}
+
+// 消息路由
+func (*PasswordHandler) route(bot *methods.BotExt, query *types.CallbackQuery) Handler {
+	return nil
+}
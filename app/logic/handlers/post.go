@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/zhangpanyi/basebot/logger"
+	"github.com/zhangpanyi/basebot/telegram/types"
+	"luckybot/app/storage/models"
+)
+
+// BindPostedLuckyMoney 发送者在群组中发出红包时绑定首发群组
+func BindPostedLuckyMoney(message *types.Message) {
+	// 忽略转发消息
+	if message.ForwardFrom != nil || message.ForwardFromChat != nil || message.ForwardDate != 0 {
+		return
+	}
+
+	// 解析红包ID
+	fields := strings.Fields(message.Text)
+	if len(fields) < 2 || fields[0] != "🎁" {
+		return
+	}
+	id, err := strconv.ParseUint(fields[1], 10, 64)
+	if err != nil {
+		return
+	}
+
+	// 检查发送者链接
+	link := "tg://user?id=" + strconv.FormatInt(message.From.ID, 10)
+	posted := false
+	for _, entity := range message.Entities {
+		if entity.Type == "text_link" && entity.URL == link {
+			posted = true
+			break
+		}
+	}
+	if !posted {
+		return
+	}
+
+	model := models.LuckyMoneyModel{}
+	bound, err := model.BindChat(id, message.From.ID, message.Chat.ID)
+	if err != nil {
+		logger.Warnf("Failed to bind lucky money chat, id: %d, chat_id: %d, %v", id, message.Chat.ID, err)
+		return
+	}
+	if bound {
+		logger.Infof("Bind lucky money chat, id: %d, chat_id: %d", id, message.Chat.ID)
+	}
+}
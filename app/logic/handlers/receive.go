@@ -3,23 +3,32 @@ package handlers
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/zhangpanyi/basebot/history"
 	"github.com/zhangpanyi/basebot/logger"
 	"github.com/zhangpanyi/basebot/telegram/methods"
 	"github.com/zhangpanyi/basebot/telegram/types"
 	"luckybot/app/config"
+	"luckybot/app/fmath"
+	"luckybot/app/location"
+	"luckybot/app/logic/botext"
+	"luckybot/app/logic/handlers/utils"
+	"luckybot/app/logic/pusher"
+	"luckybot/app/logic/scriptengine"
 	"luckybot/app/storage"
 	"luckybot/app/storage/models"
 )
 
-// ReplyLuckyMoneyInfo 回复红包信息
-func ReplyLuckyMoneyInfo(bot *methods.BotExt, fromID int64, inlineMessageID string,
+// ReplyLuckyMoneyInfo 回复红包信息, 群组共享的红包消息使用发送者语言
+func ReplyLuckyMoneyInfo(bot *methods.BotExt, inlineMessageID string,
 	luckyMoney *models.LuckyMoney, received uint32, expired bool) {
 
 	// 获取领取记录
 	size := 0
+	senderID := luckyMoney.SenderID
 	users := make([]string, 0)
 	model := models.LuckyMoneyModel{}
 	history, err := model.GetReceiveHistory(luckyMoney.ID)
@@ -29,8 +38,8 @@ func ReplyLuckyMoneyInfo(bot *methods.BotExt, fromID int64, inlineMessageID stri
 	serveCfg := config.GetServe()
 	for i := 0; i < len(history); i++ {
 		user := history[i].User
-		message := tr(fromID, "lng_chat_receive_history")
-		message = fmt.Sprintf(message, user.FirstName, user.UserID, history[i].Value.String(), luckyMoney.Asset)
+		message := tr(senderID, "lng_chat_receive_history")
+		message = fmt.Sprintf(message, user.FirstName, user.UserID, formatAmount(luckyMoney.Asset, history[i].Value), luckyMoney.Asset)
 
 		size += len(message)
 		if size > serveCfg.MaxHistoryTextLen {
@@ -44,17 +53,17 @@ func ReplyLuckyMoneyInfo(bot *methods.BotExt, fromID int64, inlineMessageID stri
 	menus := make([]methods.InlineKeyboardButton, 0)
 	if received == luckyMoney.Number {
 		menus = append(menus, methods.InlineKeyboardButton{
-			Text:         tr(fromID, "lng_chat_finished"),
+			Text:         tr(senderID, "lng_chat_finished"),
 			CallbackData: "removed",
 		})
 	} else if expired {
 		menus = append(menus, methods.InlineKeyboardButton{
-			Text:         tr(fromID, "lng_chat_expired"),
+			Text:         tr(senderID, "lng_chat_expired"),
 			CallbackData: "expired",
 		})
 	} else {
 		menus = append(menus, methods.InlineKeyboardButton{
-			Text:         tr(fromID, "lng_chat_receive"),
+			Text:         tr(senderID, "lng_chat_receive"),
 			CallbackData: luckyMoney.SN,
 		})
 	}
@@ -65,21 +74,29 @@ func ReplyLuckyMoneyInfo(bot *methods.BotExt, fromID int64, inlineMessageID stri
 	if received == luckyMoney.Number {
 		best, worst, err := model.GetBestAndWorst(luckyMoney.ID)
 		if err == nil && luckyMoney.Number > 1 && luckyMoney.Lucky {
-			settle = tr(fromID, "lng_chat_receive_settle")
+			settle = tr(senderID, "lng_chat_receive_settle")
 			settle = fmt.Sprintf(settle,
-				best.User.FirstName, best.User.UserID, best.Value.String(), luckyMoney.Asset,
-				worst.User.FirstName, worst.User.UserID, worst.Value.String(), luckyMoney.Asset)
+				best.User.FirstName, best.User.UserID, formatAmount(luckyMoney.Asset, best.Value), luckyMoney.Asset,
+				worst.User.FirstName, worst.User.UserID, formatAmount(luckyMoney.Asset, worst.Value), luckyMoney.Asset)
 		}
 	}
 
 	// 更新红包信息
 	message := makeBaseMessage(luckyMoney, received)
 	if len(users) > 0 {
-		message = fmt.Sprintf(tr(fromID, "lng_chat_receive_format"), message, strings.Join(users, ","), settle)
+		message = fmt.Sprintf(tr(senderID, "lng_chat_receive_format"), message, strings.Join(users, ","), settle)
 	}
 	_, _ = bot.EditReplyMarkupByInlineMessageID(inlineMessageID, message, true, replyMarkup)
 }
 
+// RefreshLuckyMoney 红包开抢后更新内联消息
+func RefreshLuckyMoney(bot *methods.BotExt, luckyMoney *models.LuckyMoney, received uint32,
+	inlineMessageIDs []string) {
+	for _, inlineMessageID := range inlineMessageIDs {
+		ReplyLuckyMoneyInfo(bot, inlineMessageID, luckyMoney, received, false)
+	}
+}
+
 // ReceiveHandler 领取红包
 type ReceiveHandler struct {
 }
@@ -127,9 +144,99 @@ func (handler *ReceiveHandler) answerReceiveError(bot *methods.BotExt, query *ty
 		return
 	}
 
+	// 专属红包
+	if errors.Is(err, models.ErrPermissionDenied) {
+		_ = bot.AnswerCallbackQuery(query, tr(fromID, "lng_chat_not_recipient"), true, "", 0)
+		return
+	}
+
+	// 不在范围
+	if errors.Is(err, models.ErrOutOfScope) {
+		_ = bot.AnswerCallbackQuery(query, tr(fromID, "lng_chat_out_of_scope"), true, "", 0)
+		return
+	}
+
 	logger.Errorf("Failed to receive lucky money, id: %d, user_id: %d, %v",
 		id, fromID, err)
-	_ = bot.AnswerCallbackQuery(query, tr(0, "lng_chat_receive_error"), false, "", 0)
+	_ = bot.AnswerCallbackQuery(query, tr(fromID, "lng_chat_receive_error"), false, "", 0)
+}
+
+// 提示验证口令
+func (handler *ReceiveHandler) answerPasswordRequired(bot *methods.BotExt, query *types.CallbackQuery,
+	luckyMoney *models.LuckyMoney) {
+
+	// 私聊回答问题
+	if isQuestionLuckyMoney(luckyMoney) {
+		_ = bot.AnswerCallbackQuery(query, "", false, makePasswordStartURL(bot, luckyMoney), 0)
+		return
+	}
+
+	// 群组发送口令
+	_ = bot.AnswerCallbackQuery(query, tr(query.From.ID, "lng_chat_password_required"), true, "", 0)
+}
+
+// 提示开抢倒计时
+func (handler *ReceiveHandler) answerNotStarted(bot *methods.BotExt, query *types.CallbackQuery,
+	luckyMoney *models.LuckyMoney) {
+
+	// 记录内联消息
+	model := models.LuckyMoneyModel{}
+	if err := model.AddInlineMessage(luckyMoney.ID, *query.InlineMessageID); err != nil {
+		logger.Warnf("Failed to add inline message of lucky money, %d, %v", luckyMoney.ID, err)
+	}
+
+	// 回复倒计时
+	fromID := query.From.ID
+	countdown := formatCountdown(luckyMoney.ActivateAt - time.Now().UTC().Unix())
+	reply := fmt.Sprintf(tr(fromID, "lng_chat_not_started"), location.Format(luckyMoney.ActivateAt), countdown)
+	_ = bot.AnswerCallbackQuery(query, reply, true, "", 0)
+}
+
+// 脚本检查领取, 返回额外奖励和是否允许领取
+func (handler *ReceiveHandler) checkReceiveByScript(luckyMoney *models.LuckyMoney, received uint32,
+	user *models.LuckyMoneyUser, chatInstance string) (*fmath.Amount, bool) {
+
+	event := scriptengine.LuckyMoneyEvent(luckyMoney)
+	event["received"] = float64(received)
+	event["user_id"] = strconv.FormatInt(user.UserID, 10)
+	event["first_name"] = user.FirstName
+	event["user_name"] = user.UserName
+	event["chat_instance"] = chatInstance
+	allow, text, err := scriptengine.Engine().OnLuckyMoneyReceived(event)
+	if err != nil {
+		logger.Errorf("Failed to call on_lucky_money_received, deny receiving, id: %d, user_id: %d, %v",
+			luckyMoney.ID, user.UserID, err)
+	}
+	if !allow || len(text) == 0 {
+		return nil, allow
+	}
+
+	serveCfg := config.GetServe()
+	bonus, err := fmath.Parse(text, serveCfg.Precision(luckyMoney.Asset))
+	if err != nil || bonus.Sign() <= 0 {
+		logger.Warnf("Invalid bonus of lucky money from script, id: %d, user_id: %d, bonus: %s",
+			luckyMoney.ID, user.UserID, text)
+		return nil, allow
+	}
+	return bonus, allow
+}
+
+// 处理领取失败
+func (handler *ReceiveHandler) answerReceiveFailure(bot *methods.BotExt, query *types.CallbackQuery,
+	luckyMoney *models.LuckyMoney, received uint32, err error) {
+
+	if errors.Is(err, models.ErrPasswordRequired) {
+		handler.answerPasswordRequired(bot, query, luckyMoney)
+		return
+	}
+	if errors.Is(err, models.ErrNotStarted) {
+		handler.answerNotStarted(bot, query, luckyMoney)
+		return
+	}
+	handler.answerReceiveError(bot, query, luckyMoney.ID, err)
+	if errors.Is(err, models.ErrLuckyMoneydExpired) {
+		ReplyLuckyMoneyInfo(bot, *query.InlineMessageID, luckyMoney, received, true)
+	}
 }
 
 // 处理领取红包
@@ -147,7 +254,7 @@ func (handler *ReceiveHandler) handleReceiveLuckyMoney(bot *methods.BotExt, quer
 	luckyMoney, received, err := model.GetLuckyMoney(id)
 	if err != nil {
 		logger.Errorf("Failed to get lucky money, %v", err)
-		_ = bot.AnswerCallbackQuery(query, tr(0, "lng_chat_receive_error"), false, "", 0)
+		_ = bot.AnswerCallbackQuery(query, tr(fromID, "lng_chat_receive_error"), false, "", 0)
 		return
 	}
 
@@ -163,44 +270,82 @@ func (handler *ReceiveHandler) handleReceiveLuckyMoney(bot *methods.BotExt, quer
 		return
 	}
 
-	// 执行领取红包
-	value, _, err := model.ReceiveLuckyMoney(id, fromID, query.From.FirstName)
-	if err != nil {
-		handler.answerReceiveError(bot, query, id, err)
-		if errors.Is(err, models.ErrLuckyMoneydExpired) {
-			ReplyLuckyMoneyInfo(bot, fromID, *query.InlineMessageID, luckyMoney, received, true)
-		}
+	// 检查账户冻结
+	if reply, frozen := accountFrozenReply(fromID); frozen {
+		_ = bot.AnswerCallbackQuery(query, reply, true, "", 0)
 		return
 	}
-	logger.Warnf("Receive lucky money, id: %d, user_id: %d, value: %s", id, fromID, value.String())
 
-	// 更新资产信息
-	accountModel := models.AccountModel{}
-	_, toAccount, err := accountModel.TransferFromLockAccount(luckyMoney.SenderID, fromID,
-		luckyMoney.Asset, value)
-	if err != nil {
-		logger.Fatalf("Failed to transfer from lock account, from: %d, to: %d, asset: %s, amount: %s, %v",
-			fromID, fromID, luckyMoney.Asset, value.String(), err)
+	// 同步群组成员
+	if chats := luckyMoney.ScopeChats(); len(chats) > 0 {
+		syncChatMember(bot, chats, fromID)
+	}
+
+	// 检查领取条件
+	user := models.LuckyMoneyUser{UserID: fromID, FirstName: query.From.FirstName}
+	if query.From.UserName != nil {
+		user.UserName = *query.From.UserName
+	}
+	if err = model.CheckReceive(id, &user, query.ChatInstance); err != nil {
+		handler.answerReceiveFailure(bot, query, luckyMoney, received, err)
 		return
 	}
 
-	// 插入账户记录
-	versionModel := models.AccountVersionModel{}
-	_, _ = versionModel.InsertVersion(fromID, &models.Version{
-		Symbol:          luckyMoney.Asset,
-		Balance:         value,
-		Amount:          toAccount.Amount,
-		Reason:          models.ReasonReceive,
-		RefLuckyMoneyID: &luckyMoney.ID,
-		RefUserID:       &luckyMoney.SenderID,
-		RefUserName:     &luckyMoney.SenderName,
-	})
+	// 脚本检查领取
+	bonus, ok := handler.checkReceiveByScript(luckyMoney, received, &user, query.ChatInstance)
+	if !ok {
+		_ = bot.AnswerCallbackQuery(query, tr(fromID, "lng_chat_receive_denied"), true, "", 0)
+		return
+	}
+
+	// 执行领取红包, 额外奖励在同一事务中发放
+	value, _, bonusVersion, err := model.ReceiveLuckyMoney(id, &user, query.ChatInstance, bonus)
+	if err != nil {
+		handler.answerReceiveFailure(bot, query, luckyMoney, received, err)
+		return
+	}
+	logger.Warnf("Receive lucky money, id: %d, user_id: %d, value: %s", id, fromID, formatAmount(luckyMoney.Asset, value))
 
 	// 发送领取通知
-	alert := tr(0, "lng_chat_receive_success")
-	alert = fmt.Sprintf(alert, value.String(), luckyMoney.Asset, bot.UserName)
+	alert := tr(fromID, "lng_chat_receive_success")
+	alert = fmt.Sprintf(alert, formatAmount(luckyMoney.Asset, value), luckyMoney.Asset, bot.UserName)
+	if bonusVersion != nil {
+		logger.Warnf("Grant bonus of lucky money, id: %d, user_id: %d, bonus: %s",
+			id, fromID, formatAmount(luckyMoney.Asset, bonus))
+		alert += fmt.Sprintf(tr(fromID, "lng_chat_receive_bonus"), formatAmount(luckyMoney.Asset, bonus), luckyMoney.Asset)
+		pusher.Post(fromID, utils.MakeHistoryMessage(fromID, bonusVersion), true, nil)
+	}
 	_ = bot.AnswerCallbackQuery(query, alert, true, "", 0)
 
 	// 回复红包信息
-	ReplyLuckyMoneyInfo(bot, fromID, *query.InlineMessageID, luckyMoney, received+1, false)
+	ReplyLuckyMoneyInfo(bot, *query.InlineMessageID, luckyMoney, received+1, false)
+}
+
+// 同步群组成员
+func syncChatMember(bot *methods.BotExt, chats []int64, userID int64) {
+	model := models.ChatMemberModel{}
+	for _, chatID := range chats {
+		member, err := model.IsMember(chatID, userID)
+		if err == nil && member {
+			return
+		}
+	}
+
+	for _, chatID := range chats {
+		member, err := botext.IsChatMember(bot, chatID, userID)
+		if err != nil {
+			logger.Warnf("Failed to get chat member, chat_id: %d, user_id: %d, %v", chatID, userID, err)
+			continue
+		}
+		if !member {
+			if err = model.RemoveMember(chatID, userID); err != nil {
+				logger.Warnf("Failed to remove chat member, chat_id: %d, user_id: %d, %v", chatID, userID, err)
+			}
+			continue
+		}
+		if err = model.AddMember(chatID, userID); err != nil {
+			logger.Warnf("Failed to add chat member, chat_id: %d, user_id: %d, %v", chatID, userID, err)
+		}
+		return
+	}
 }
@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zhangpanyi/basebot/history"
+	"github.com/zhangpanyi/basebot/logger"
+	"github.com/zhangpanyi/basebot/telegram/methods"
+	"github.com/zhangpanyi/basebot/telegram/types"
+	"luckybot/app/location"
+	"luckybot/app/monitor"
+	"luckybot/app/storage/models"
+)
+
+// 最长推迟天数
+const maxScheduleDays = 7
+
+// 匹配红包
+var reMathSchedule *regexp.Regexp
+
+// 匹配延迟
+var reMathScheduleDelay *regexp.Regexp
+
+func init() {
+	var err error
+	reMathSchedule, err = regexp.Compile("^/schedule/(\\w+)/$")
+	if err != nil {
+		panic(err)
+	}
+
+	reMathScheduleDelay, err = regexp.Compile("^/schedule/(\\w+)/(\\d+)/$")
+	if err != nil {
+		panic(err)
+	}
+}
+
+// 解析开抢时间, 支持 HH:MM 和 YYYY-MM-DD HH:MM
+func parseActivateAt(text string) (int64, bool) {
+	text = strings.TrimSpace(text)
+	now := location.Now()
+	if t, err := location.ParseInLocation("2006-01-02 15:04", text); err == nil {
+		return t.UTC().Unix(), true
+	}
+
+	t, err := location.ParseInLocation("15:04", text)
+	if err != nil {
+		return 0, false
+	}
+	activate := time.Date(now.Year(), now.Month(), now.Day(), t.Hour(), t.Minute(), 0, 0, now.Location())
+	if !activate.After(now) {
+		activate = activate.AddDate(0, 0, 1)
+	}
+	return activate.UTC().Unix(), true
+}
+
+// ScheduleHandler 定时开抢
+type ScheduleHandler struct {
+}
+
+// Handle 消息处理
+func (handler *ScheduleHandler) Handle(bot *methods.BotExt, r *history.History, update *types.Update) {
+	// 处理预设延迟
+	data := update.CallbackQuery.Data
+	result := reMathScheduleDelay.FindStringSubmatch(data)
+	if len(result) == 3 {
+		r.Clear()
+		delay, _ := strconv.ParseInt(result[2], 10, 64)
+		handler.handleSchedule(bot, update.CallbackQuery, result[1], time.Now().UTC().Unix()+delay, true)
+		return
+	}
+
+	// 回复输入开抢时间
+	result = reMathSchedule.FindStringSubmatch(data)
+	if len(result) == 2 {
+		handler.replyEnterTime(bot, r, update, result[1])
+		return
+	}
+}
+
+// 消息路由
+func (handler *ScheduleHandler) route(bot *methods.BotExt, query *types.CallbackQuery) Handler {
+	return nil
+}
+
+// 回复输入开抢时间
+func (handler *ScheduleHandler) replyEnterTime(bot *methods.BotExt, r *history.History,
+	update *types.Update, sn string) {
+
+	// 处理输入时间
+	query := update.CallbackQuery
+	fromID := query.From.ID
+	back, err := r.Back()
+	if err == nil && back.Message != nil {
+		activateAt, ok := parseActivateAt(back.Message.Text)
+		if !ok {
+			r.Pop()
+			_, _ = bot.SendMessage(fromID, tr(fromID, "lng_schedule_error"), true, nil)
+			return
+		}
+		r.Clear()
+		handler.handleSchedule(bot, query, sn, activateAt, false)
+		return
+	}
+
+	// 生成菜单列表
+	menus := [...]methods.InlineKeyboardButton{
+		methods.InlineKeyboardButton{
+			Text:         fmt.Sprintf(tr(fromID, "lng_schedule_after_minutes"), 10),
+			CallbackData: query.Data + "600/",
+		},
+		methods.InlineKeyboardButton{
+			Text:         fmt.Sprintf(tr(fromID, "lng_schedule_after_minutes"), 30),
+			CallbackData: query.Data + "1800/",
+		},
+		methods.InlineKeyboardButton{
+			Text:         fmt.Sprintf(tr(fromID, "lng_schedule_after_hours"), 1),
+			CallbackData: query.Data + "3600/",
+		},
+		methods.InlineKeyboardButton{
+			Text:         tr(fromID, "lng_back_menu"),
+			CallbackData: "/main/",
+		},
+	}
+	markup := methods.MakeInlineKeyboardMarkup(menus[:], 3, 1)
+
+	// 提示输入开抢时间
+	r.Clear().Push(update)
+	reply := fmt.Sprintf(tr(fromID, "lng_schedule_enter"), maxScheduleDays)
+	_ = bot.AnswerCallbackQuery(query, tr(fromID, "lng_schedule_enter_answer"), false, "", 0)
+	_, _ = bot.SendMessage(fromID, reply, true, markup)
+}
+
+// 处理设置开抢时间
+func (handler *ScheduleHandler) handleSchedule(bot *methods.BotExt, query *types.CallbackQuery,
+	sn string, activateAt int64, edit bool) {
+
+	// 回复消息
+	fromID := query.From.ID
+	reply := func(text string, markup *methods.InlineKeyboardMarkup) {
+		_ = bot.AnswerCallbackQuery(query, "", false, "", 0)
+		if edit {
+			_, _ = bot.EditMessageReplyMarkup(query.Message, text, true, markup)
+		} else {
+			_, _ = bot.SendMessage(fromID, text, true, markup)
+		}
+	}
+
+	// 检查开抢时间
+	now := time.Now().UTC().Unix()
+	if activateAt <= now || activateAt > now+maxScheduleDays*24*3600 {
+		reply(tr(fromID, "lng_schedule_error"), nil)
+		return
+	}
+
+	// 设置开抢时间
+	model := models.LuckyMoneyModel{}
+	id, err := model.GetLuckyMoneyIDBySN(sn)
+	if err != nil {
+		reply(tr(fromID, "lng_chat_invalid_id"), nil)
+		return
+	}
+	luckyMoney, err := model.SetActivateAt(id, fromID, activateAt)
+	if err != nil {
+		if !errors.Is(err, models.ErrAlreadyActivated) && !errors.Is(err, models.ErrLuckyMoneydExpired) &&
+			!errors.Is(err, models.ErrPermissionDenied) {
+			logger.Warnf("Failed to set activate time of lucky money, id: %d, user_id: %d, %v", id, fromID, err)
+		}
+		reply(tr(fromID, "lng_schedule_not_allowed"), nil)
+		return
+	}
+	monitor.AddToQueue(luckyMoney)
+	logger.Infof("Schedule lucky money, id: %d, user_id: %d, activate_at: %d", id, fromID, activateAt)
+
+	// 回复设置结果
+	menus := [...]methods.InlineKeyboardButton{
+		methods.InlineKeyboardButton{
+			Text:              tr(fromID, "lng_send_luckymoney"),
+			SwitchInlineQuery: luckyMoney.SN,
+		},
+	}
+	markup := methods.MakeInlineKeyboardMarkupAuto(menus[:], 1)
+	reply(fmt.Sprintf(tr(fromID, "lng_schedule_success"), luckyMoney.ID, location.Format(activateAt)), markup)
+}
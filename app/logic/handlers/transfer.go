@@ -0,0 +1,590 @@
+package handlers
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/zhangpanyi/basebot/history"
+	"github.com/zhangpanyi/basebot/logger"
+	"github.com/zhangpanyi/basebot/telegram/methods"
+	"github.com/zhangpanyi/basebot/telegram/types"
+	"luckybot/app/config"
+	"luckybot/app/fmath"
+	"luckybot/app/logic/pusher"
+	"luckybot/app/storage/models"
+)
+
+// 匹配资产
+var reMathTransferAsset *regexp.Regexp
+
+// 匹配收款人
+var reMathTransferRecipient *regexp.Regexp
+
+// 匹配金额
+var reMathTransferAmount *regexp.Regexp
+
+// 匹配提交
+var reMathTransferSubmit *regexp.Regexp
+
+// 匹配内联确认
+var reMathTransferInline *regexp.Regexp
+
+// 匹配内联查询
+var reMathTransferQuery *regexp.Regexp
+
+func init() {
+	var err error
+	reMathTransferAsset, err = regexp.Compile("^/transfer/(\\w+)/$")
+	if err != nil {
+		panic(err)
+	}
+
+	reMathTransferRecipient, err = regexp.Compile("^/transfer/(\\w+)/(\\d+)/$")
+	if err != nil {
+		panic(err)
+	}
+
+	reMathTransferAmount, err = regexp.Compile("^/transfer/(\\w+)/(\\d+)/([0-9]+\\.?[0-9]*)/$")
+	if err != nil {
+		panic(err)
+	}
+
+	reMathTransferSubmit, err = regexp.Compile("^/transfer/(\\w+)/(\\d+)/([0-9]+\\.?[0-9]*)/submit/$")
+	if err != nil {
+		panic(err)
+	}
+
+	reMathTransferInline, err = regexp.Compile("^/transfer/(\\w+)/(\\d+)/([0-9]+\\.?[0-9]*)/(\\d+)/$")
+	if err != nil {
+		panic(err)
+	}
+
+	reMathTransferQuery, err = regexp.Compile("^transfer\\s+([0-9]+\\.?[0-9]*)\\s+(?:(\\w+)\\s+)?@(\\w+)\\s*$")
+	if err != nil {
+		panic(err)
+	}
+}
+
+// TransferHandler 转账
+type TransferHandler struct {
+}
+
+// 转账信息
+type transferInfo struct {
+	asset       string        // 资产类型
+	recipientID int64         // 收款用户
+	amount      *fmath.Amount // 转账金额
+}
+
+// Handle 消息处理
+func (handler *TransferHandler) Handle(bot *methods.BotExt, r *history.History, update *types.Update) {
+	// 内联消息确认
+	query := update.CallbackQuery
+	if query.InlineMessageID != nil {
+		handler.handleInlineConfirm(bot, query)
+		return
+	}
+
+	// 检查账户冻结
+	if replyAccountFrozen(bot, r, update) {
+		return
+	}
+
+	// 回复选择资产
+	info := new(transferInfo)
+	data := query.Data
+	if data == "/transfer/" {
+		r.Clear()
+		handler.replyChooseAsset(bot, query)
+		return
+	}
+
+	// 回复输入收款人
+	serverCfg := config.GetServe()
+	result := reMathTransferAsset.FindStringSubmatch(data)
+	if len(result) == 2 {
+		if _, ok := serverCfg.GetAsset(result[1]); !ok {
+			return
+		}
+		info.asset = result[1]
+		handler.replyEnterRecipient(bot, r, info, update)
+		return
+	}
+
+	// 回复输入金额
+	result = reMathTransferRecipient.FindStringSubmatch(data)
+	if len(result) == 3 {
+		if _, ok := serverCfg.GetAsset(result[1]); !ok {
+			return
+		}
+		info.asset = result[1]
+		info.recipientID, _ = strconv.ParseInt(result[2], 10, 64)
+		handler.replyEnterAmount(bot, r, info, update, true)
+		return
+	}
+
+	// 回复转账总览
+	result = reMathTransferAmount.FindStringSubmatch(data)
+	if len(result) == 4 {
+		if !handler.parseInfo(info, result) {
+			return
+		}
+		handler.replyTransferOverview(bot, info, update, true)
+		return
+	}
+
+	// 处理转账请求
+	result = reMathTransferSubmit.FindStringSubmatch(data)
+	if len(result) == 4 {
+		if !handler.parseInfo(info, result) {
+			return
+		}
+		r.Clear()
+		handler.handleTransfer(bot, info, query)
+		return
+	}
+}
+
+// 消息路由
+func (handler *TransferHandler) route(bot *methods.BotExt, query *types.CallbackQuery) Handler {
+	return nil
+}
+
+// 解析转账信息
+func (handler *TransferHandler) parseInfo(info *transferInfo, result []string) bool {
+	serverCfg := config.GetServe()
+	asset, ok := serverCfg.GetAsset(result[1])
+	if !ok {
+		return false
+	}
+	amount, err := fmath.Parse(result[3], asset.Precision)
+	if err != nil {
+		return false
+	}
+	info.asset = result[1]
+	info.recipientID, _ = strconv.ParseInt(result[2], 10, 64)
+	info.amount = amount
+	return true
+}
+
+// 获取转账用户名
+func getTransferUserName(userID int64) string {
+	model := models.UserModel{}
+	user, err := model.GetUser(userID)
+	if err != nil {
+		return strconv.FormatInt(userID, 10)
+	}
+	if len(user.UserName) > 0 {
+		return user.UserName
+	}
+	if len(user.FirstName) > 0 {
+		return user.FirstName
+	}
+	return strconv.FormatInt(userID, 10)
+}
+
+// 生成转账菜单
+func makeTransferMenus(fromID int64, data string) *methods.InlineKeyboardMarkup {
+	menus := [...]methods.InlineKeyboardButton{
+		methods.InlineKeyboardButton{
+			Text:         tr(fromID, "lng_transfer_cancel"),
+			CallbackData: "/main/",
+		},
+		methods.InlineKeyboardButton{
+			Text:         tr(fromID, "lng_back_superior"),
+			CallbackData: backSuperior(data),
+		},
+	}
+	return methods.MakeInlineKeyboardMarkupAuto(menus[:], 1)
+}
+
+// 获取每日剩余额度, 不限制时返回nil
+func getTransferRemaining(userID int64, asset *config.Asset) *fmath.Amount {
+	limit := asset.TransferDailyLimitAmount()
+	if limit.Sign() <= 0 {
+		return nil
+	}
+
+	model := models.TransferModel{}
+	used, err := model.GetDailyUsed(userID, asset.Symbol)
+	if err != nil {
+		logger.Warnf("Failed to get transfer daily used, %v, %v, %v", userID, asset.Symbol, err)
+		return fmath.Zero()
+	}
+	if used.Cmp(limit) >= 0 {
+		return fmath.Zero()
+	}
+	return fmath.Sub(limit, used)
+}
+
+// 回复选择资产
+func (handler *TransferHandler) replyChooseAsset(bot *methods.BotExt, query *types.CallbackQuery) {
+	fromID := query.From.ID
+	reply := tr(fromID, "lng_transfer_choose_asset")
+	markup := makeAssetMenus(fromID, query.Data, "/main/")
+	_ = bot.AnswerCallbackQuery(query, "", false, "", 0)
+	_, _ = bot.EditMessageReplyMarkup(query.Message, reply, true, markup)
+}
+
+// 处理输入收款人
+func (handler *TransferHandler) handleEnterRecipient(bot *methods.BotExt, r *history.History,
+	info *transferInfo, update *types.Update, message *types.Message) {
+
+	// 处理错误
+	query := update.CallbackQuery
+	fromID := query.From.ID
+	handlerError := func(reply string) {
+		r.Pop()
+		markup := makeTransferMenus(fromID, query.Data)
+		_ = bot.AnswerCallbackQuery(query, "", false, "", 0)
+		_, _ = bot.SendMessage(fromID, reply, true, markup)
+	}
+
+	// 解析收款人
+	recipientID, recipientName, ok := parseRecipient(message)
+	if !ok {
+		handlerError(tr(fromID, "lng_transfer_recipient_error"))
+		return
+	}
+
+	// 检查收款人
+	model := models.UserModel{}
+	if len(recipientName) > 0 {
+		id, err := model.GetUserIDByName(recipientName)
+		if err != nil {
+			handlerError(tr(fromID, "lng_transfer_recipient_unknown"))
+			return
+		}
+		recipientID = id
+	} else if _, err := model.GetUser(recipientID); err != nil {
+		handlerError(tr(fromID, "lng_transfer_recipient_unknown"))
+		return
+	}
+	if recipientID == fromID {
+		handlerError(tr(fromID, "lng_transfer_recipient_self"))
+		return
+	}
+
+	// 更新下个操作状态
+	r.Clear()
+	info.recipientID = recipientID
+	update.CallbackQuery.Data += strconv.FormatInt(recipientID, 10) + "/"
+	handler.replyEnterAmount(bot, r, info, update, false)
+}
+
+// 回复输入收款人
+func (handler *TransferHandler) replyEnterRecipient(bot *methods.BotExt, r *history.History,
+	info *transferInfo, update *types.Update) {
+
+	// 处理输入收款人
+	back, err := r.Back()
+	if err == nil && back.Message != nil {
+		handler.handleEnterRecipient(bot, r, info, update, back.Message)
+		return
+	}
+
+	// 提示输入收款人
+	r.Clear().Push(update)
+	query := update.CallbackQuery
+	fromID := query.From.ID
+	markup := makeTransferMenus(fromID, query.Data)
+	reply := fmt.Sprintf(tr(fromID, "lng_transfer_enter_recipient"), info.asset)
+	_, _ = bot.EditMessageReplyMarkup(query.Message, reply, true, markup)
+	_ = bot.AnswerCallbackQuery(query, tr(fromID, "lng_transfer_enter_recipient_answer"), false, "", 0)
+}
+
+// 处理输入转账金额
+func (handler *TransferHandler) handleEnterAmount(bot *methods.BotExt, r *history.History,
+	info *transferInfo, update *types.Update, amount string) {
+
+	// 处理错误
+	query := update.CallbackQuery
+	fromID := query.From.ID
+	handlerError := func(reply string) {
+		r.Pop()
+		markup := makeTransferMenus(fromID, query.Data)
+		_ = bot.AnswerCallbackQuery(query, "", false, "", 0)
+		_, _ = bot.SendMessage(fromID, reply, true, markup)
+	}
+
+	// 检查输入金额
+	serverCfg := config.GetServe()
+	asset, _ := serverCfg.GetAsset(info.asset)
+	balance, _ := getUserBalance(fromID, asset.Symbol)
+	value, err := fmath.Parse(amount, asset.Precision)
+	if err != nil || value.Sign() <= 0 || balance.Cmp(value) == -1 {
+		reply := tr(fromID, "lng_transfer_amount_error")
+		handlerError(fmt.Sprintf(reply, balance.Format(asset.Precision), asset.Symbol))
+		return
+	}
+
+	// 检查每日限额
+	remaining := getTransferRemaining(fromID, asset)
+	if remaining != nil && value.Cmp(remaining) == 1 {
+		reply := tr(fromID, "lng_transfer_limit_exceeded")
+		handlerError(fmt.Sprintf(reply, remaining.Format(asset.Precision), asset.Symbol))
+		return
+	}
+
+	// 更新下个操作状态
+	r.Clear()
+	info.amount = value
+	update.CallbackQuery.Data += amount + "/"
+	handler.replyTransferOverview(bot, info, update, false)
+}
+
+// 回复输入转账金额
+func (handler *TransferHandler) replyEnterAmount(bot *methods.BotExt, r *history.History,
+	info *transferInfo, update *types.Update, edit bool) {
+
+	// 处理输入金额
+	back, err := r.Back()
+	if err == nil && back.Message != nil {
+		handler.handleEnterAmount(bot, r, info, update, back.Message.Text)
+		return
+	}
+
+	// 提示输入转账金额
+	r.Clear().Push(update)
+	query := update.CallbackQuery
+	fromID := query.From.ID
+	markup := makeTransferMenus(fromID, query.Data)
+
+	serverCfg := config.GetServe()
+	asset, _ := serverCfg.GetAsset(info.asset)
+	balance, _ := getUserBalance(fromID, asset.Symbol)
+	reply := fmt.Sprintf(tr(fromID, "lng_transfer_enter_amount"), getTransferUserName(info.recipientID),
+		info.recipientID, balance.Format(asset.Precision), asset.Symbol)
+	if remaining := getTransferRemaining(fromID, asset); remaining != nil {
+		reply += fmt.Sprintf(tr(fromID, "lng_transfer_limit_tip"), remaining.Format(asset.Precision), asset.Symbol)
+	}
+	if !edit {
+		_, _ = bot.SendMessage(fromID, reply, true, markup)
+	} else {
+		_, _ = bot.EditMessageReplyMarkup(query.Message, reply, true, markup)
+	}
+
+	answer := fmt.Sprintf(tr(fromID, "lng_transfer_enter_amount_answer"), asset.Symbol)
+	_ = bot.AnswerCallbackQuery(query, answer, false, "", 0)
+}
+
+// 回复转账总览
+func (handler *TransferHandler) replyTransferOverview(bot *methods.BotExt, info *transferInfo,
+	update *types.Update, edit bool) {
+
+	// 应答请求
+	query := update.CallbackQuery
+	fromID := query.From.ID
+	_ = bot.AnswerCallbackQuery(query, tr(fromID, "lng_transfer_overview_answer"), false, "", 0)
+
+	// 格式化信息
+	serverCfg := config.GetServe()
+	asset, _ := serverCfg.GetAsset(info.asset)
+	reply := fmt.Sprintf(tr(fromID, "lng_transfer_overview"), getTransferUserName(info.recipientID),
+		info.recipientID, info.amount.Format(asset.Precision), asset.Symbol)
+
+	// 生成菜单按钮
+	menus := [...]methods.InlineKeyboardButton{
+		methods.InlineKeyboardButton{
+			Text:         tr(fromID, "lng_transfer_submit"),
+			CallbackData: query.Data + "submit/",
+		},
+		methods.InlineKeyboardButton{
+			Text:         tr(fromID, "lng_back_superior"),
+			CallbackData: backSuperior(query.Data),
+		},
+	}
+	markup := methods.MakeInlineKeyboardMarkupAuto(menus[:], 1)
+
+	// 回复信息总览
+	if !edit {
+		_, _ = bot.SendMessage(fromID, reply, true, markup)
+	} else {
+		_, _ = bot.EditMessageReplyMarkup(query.Message, reply, true, markup)
+	}
+}
+
+// 处理转账
+func (handler *TransferHandler) handleTransfer(bot *methods.BotExt, info *transferInfo,
+	query *types.CallbackQuery) {
+
+	// 生成菜单
+	fromID := query.From.ID
+	menus := [...]methods.InlineKeyboardButton{
+		methods.InlineKeyboardButton{
+			Text:         tr(fromID, "lng_back_menu"),
+			CallbackData: "/main/",
+		},
+	}
+	markup := methods.MakeInlineKeyboardMarkupAuto(menus[:], 1)
+
+	// 执行转账
+	ref := fmt.Sprintf("%d:%d", query.Message.Chat.ID, query.Message.MessageID)
+	reply, ok := executeTransfer(fromID, info, ref)
+	if !ok {
+		_ = bot.AnswerCallbackQuery(query, reply, false, "", 0)
+		_, _ = bot.EditMessageReplyMarkup(query.Message, reply, false, markup)
+		return
+	}
+
+	_ = bot.AnswerCallbackQuery(query, tr(fromID, "lng_transfer_success_answer"), false, "", 0)
+	_, _ = bot.EditMessageReplyMarkup(query.Message, reply, true, markup)
+}
+
+// 处理内联消息确认
+func (handler *TransferHandler) handleInlineConfirm(bot *methods.BotExt, query *types.CallbackQuery) {
+	// 解析转账信息
+	fromID := query.From.ID
+	result := reMathTransferInline.FindStringSubmatch(query.Data)
+	if len(result) != 5 {
+		_ = bot.AnswerCallbackQuery(query, "", false, "", 0)
+		return
+	}
+	info := new(transferInfo)
+	if !handler.parseInfo(info, result) {
+		_ = bot.AnswerCallbackQuery(query, "", false, "", 0)
+		return
+	}
+
+	// 只有付款人能确认
+	senderID, _ := strconv.ParseInt(result[4], 10, 64)
+	if senderID != fromID {
+		_ = bot.AnswerCallbackQuery(query, tr(fromID, "lng_transfer_not_sender"), true, "", 0)
+		return
+	}
+
+	// 执行转账
+	reply, ok := executeTransfer(fromID, info, *query.InlineMessageID)
+	if !ok {
+		_ = bot.AnswerCallbackQuery(query, reply, true, "", 0)
+		return
+	}
+	_ = bot.AnswerCallbackQuery(query, tr(fromID, "lng_transfer_success_answer"), false, "", 0)
+	_, _ = bot.EditReplyMarkupByInlineMessageID(*query.InlineMessageID, reply, true, nil)
+}
+
+// 执行转账, 返回回复内容
+func executeTransfer(fromID int64, info *transferInfo, ref string) (string, bool) {
+	serverCfg := config.GetServe()
+	asset, _ := serverCfg.GetAsset(info.asset)
+	if info.recipientID == fromID {
+		return tr(fromID, "lng_transfer_recipient_self"), false
+	}
+	if reply, frozen := accountFrozenReply(fromID); frozen {
+		return reply, false
+	}
+
+	// 不能转账给已冻结的用户
+	accountModel := models.AccountModel{}
+	if frozen, _, err := accountModel.IsFrozen(info.recipientID); err != nil || frozen {
+		if err != nil {
+			logger.Warnf("Failed to check account frozen, %v, %v", info.recipientID, err)
+			return tr(fromID, "lng_transfer_error"), false
+		}
+		return tr(fromID, "lng_transfer_recipient_frozen"), false
+	}
+
+	fromName := getTransferUserName(fromID)
+	toName := getTransferUserName(info.recipientID)
+	model := models.TransferModel{}
+	_, err := model.Transfer(&models.Transfer{
+		Ref:      ref,
+		From:     fromID,
+		FromName: fromName,
+		To:       info.recipientID,
+		ToName:   toName,
+		Symbol:   asset.Symbol,
+		Amount:   info.amount,
+	}, asset.TransferDailyLimitAmount())
+	if err != nil {
+		switch err {
+		case models.ErrTransferProcessed:
+			return tr(fromID, "lng_transfer_processed"), false
+		case models.ErrInsufficientAmount, models.ErrNoSuchTypeAccount:
+			return tr(fromID, "lng_transfer_not_enough"), false
+		case models.ErrTransferLimitExceeded:
+			remaining := getTransferRemaining(fromID, asset)
+			reply := tr(fromID, "lng_transfer_limit_exceeded")
+			return fmt.Sprintf(reply, remaining.Format(asset.Precision), asset.Symbol), false
+		}
+		logger.Warnf("Failed to transfer, from: %d, to: %d, asset: %s, amount: %s, %v",
+			fromID, info.recipientID, asset.Symbol, info.amount.Format(asset.Precision), err)
+		return tr(fromID, "lng_transfer_error"), false
+	}
+	logger.Infof("Transfer success, from: %d, to: %d, asset: %s, amount: %s",
+		fromID, info.recipientID, asset.Symbol, info.amount.Format(asset.Precision))
+
+	// 通知收款人
+	amount := info.amount.Format(asset.Precision)
+	notice := fmt.Sprintf(tr(info.recipientID, "lng_transfer_received"), fromName, fromID, amount, asset.Symbol)
+	pusher.Post(info.recipientID, notice, true, nil)
+	return fmt.Sprintf(tr(fromID, "lng_transfer_success"), amount, asset.Symbol, toName, info.recipientID), true
+}
+
+// 回复内联转账
+func replyTransferQuery(bot *methods.BotExt, query *types.InlineQuery) {
+	// 解析查询内容
+	fromID := query.From.ID
+	result := reMathTransferQuery.FindStringSubmatch(query.Query)
+	if len(result) != 4 || len(query.Offset) > 0 {
+		replyNone(bot, query)
+		return
+	}
+
+	// 获取资产信息
+	serverCfg := config.GetServe()
+	if len(serverCfg.Assets) == 0 {
+		replyNone(bot, query)
+		return
+	}
+	asset := &serverCfg.Assets[0]
+	if len(result[2]) > 0 {
+		var ok bool
+		if asset, ok = serverCfg.GetAsset(result[2]); !ok {
+			replyNone(bot, query)
+			return
+		}
+	}
+	amount, err := fmath.Parse(result[1], asset.Precision)
+	if err != nil || amount.Sign() <= 0 {
+		replyNone(bot, query)
+		return
+	}
+
+	// 获取收款人
+	model := models.UserModel{}
+	recipientID, err := model.GetUserIDByName(result[3])
+	if err != nil || recipientID == fromID {
+		replyNone(bot, query)
+		return
+	}
+
+	// 生成转账消息
+	value := amount.Format(asset.Precision)
+	article := methods.InlineQueryResultArticle{}
+	article.ID = "0"
+	article.Title = fmt.Sprintf(tr(fromID, "lng_transfer_inline_title"), value, asset.Symbol, result[3])
+	article.Description = tr(fromID, "lng_transfer_inline_description")
+	menus := [...]methods.InlineKeyboardButton{
+		methods.InlineKeyboardButton{
+			Text:         tr(fromID, "lng_transfer_submit"),
+			CallbackData: fmt.Sprintf("/transfer/%s/%d/%s/%d/", asset.Symbol, recipientID, value, fromID),
+		},
+	}
+	article.ReplyMarkup = methods.MakeInlineKeyboardMarkupAuto(menus[:], 1)
+	article.InputMessageContent = &methods.InputTextMessageContent{
+		MessageText: fmt.Sprintf(tr(fromID, "lng_transfer_inline_message"), getTransferUserName(fromID), fromID,
+			value, asset.Symbol, getTransferUserName(recipientID), recipientID),
+		ParseMode:             methods.ParseModeMarkdown,
+		DisableWebPagePreview: true,
+	}
+	if len(asset.ThumbURL) > 0 {
+		article.ThumbWidth = 64
+		article.ThumbHeight = 64
+		article.ThumbURL = asset.ThumbURL
+	}
+
+	results := []methods.InlineQueryResult{&article}
+	_ = bot.AnswerInlineQuery(query, nil, 0, results)
+}
@@ -2,16 +2,57 @@ package utils
 
 import (
 	"fmt"
+	"strings"
 
 	"luckybot/app/config"
+	"luckybot/app/fmath"
 	"luckybot/app/storage/models"
 )
 
 // Tr 语言翻译
 func Tr(userID int64, key string) string {
-	return config.GetLanguge().Value("zh_CN", key)
+	return config.GetLanguge().Value(UserLanguage(userID), key)
 }
 
+// UserLanguage 获取用户语言
+func UserLanguage(userID int64) string {
+	languages := config.GetLanguge()
+	if userID != 0 {
+		model := models.UserModel{}
+		if code := model.GetLanguage(userID); len(code) > 0 && languages.Exist(code) {
+			return code
+		}
+	}
+	return languages.Default()
+}
+
+// 获取资产名称
+func assetName(symbol string) string {
+	serveCfg := config.GetServe()
+	asset, ok := serveCfg.GetAsset(symbol)
+	if !ok {
+		return symbol
+	}
+	return asset.Name
+}
+
+// FormatAmount 格式化资产金额
+func FormatAmount(symbol string, amount *fmath.Amount) string {
+	serveCfg := config.GetServe()
+	asset, ok := serveCfg.GetAsset(symbol)
+	if !ok {
+		return amount.String()
+	}
+	return amount.Format(asset.Precision)
+}
+
+// EscapeMarkdown 转义Markdown特殊字符
+func EscapeMarkdown(text string) string {
+	return markdownReplacer.Replace(text)
+}
+
+var markdownReplacer = strings.NewReplacer("_", "\\_", "*", "\\*", "[", "\\[", "`", "\\`")
+
 // MakeHistoryMessage 生成历史内容
 func MakeHistoryMessage(fromID int64, version *models.Version) string {
 	switch version.Reason {
@@ -19,44 +60,67 @@ func MakeHistoryMessage(fromID int64, version *models.Version) string {
 		// 发放红包
 		message := Tr(fromID, "lng_history_give")
 		return fmt.Sprintf(message, *version.RefLuckyMoneyID,
-			version.Locked.String(), version.Symbol)
+			FormatAmount(version.Symbol, version.Locked), version.Symbol)
 	case models.ReasonReceive:
 		// 领取红包
 		message := Tr(fromID, "lng_history_receive")
 		return fmt.Sprintf(message, *version.RefUserName,
-			*version.RefUserID, *version.RefLuckyMoneyID, version.Balance.String(), version.Symbol)
+			*version.RefUserID, *version.RefLuckyMoneyID, FormatAmount(version.Symbol, version.Balance), version.Symbol)
 	case models.ReasonSystem:
 		// 系统发放
 		message := Tr(fromID, "lng_history_system")
-		return fmt.Sprintf(message, version.Balance.String(), version.Symbol)
+		return fmt.Sprintf(message, FormatAmount(version.Symbol, version.Balance), version.Symbol)
 	case models.ReasonGiveBack:
 		// 退还红包
 		message := Tr(fromID, "lng_history_giveback")
 		return fmt.Sprintf(message, *version.RefLuckyMoneyID,
-			version.Locked.Abs(version.Locked).String(), version.Symbol)
+			FormatAmount(version.Symbol, fmath.Abs(version.Locked)), version.Symbol)
 	case models.ReasonDeposit:
 		// 充值代币
 		message := Tr(fromID, "lng_history_deposit")
-		return fmt.Sprintf(message, version.Balance.String(), version.Symbol,
+		return fmt.Sprintf(message, FormatAmount(version.Symbol, version.Balance), version.Symbol,
 			*version.RefBlockHeight, *version.RefTxID)
 	case models.ReasonWithdraw:
 		// 正在提现
-		serverCfg := config.GetServe()
 		message := Tr(fromID, "lng_history_withdraw")
-		return fmt.Sprintf(message, version.Locked.String(), version.Symbol, serverCfg.Name,
-			*version.RefAddress, version.Fee.String(), version.Symbol)
+		return fmt.Sprintf(message, FormatAmount(version.Symbol, version.Locked), version.Symbol, assetName(version.Symbol),
+			*version.RefAddress, FormatAmount(version.Symbol, version.Fee), version.Symbol)
 	case models.ReasonWithdrawFailure:
 		// 提现失败
-		serverCfg := config.GetServe()
 		message := Tr(fromID, "lng_history_withdraw_failure")
-		return fmt.Sprintf(message, version.Locked.Abs(version.Locked).String(), version.Symbol,
-			serverCfg.Name, *version.RefAddress)
+		return fmt.Sprintf(message, FormatAmount(version.Symbol, fmath.Abs(version.Locked)), version.Symbol,
+			assetName(version.Symbol), *version.RefAddress)
 	case models.ReasonWithdrawSuccess:
 		// 提现成功
-		serverCfg := config.GetServe()
 		message := Tr(fromID, "lng_history_withdraw_success")
-		return fmt.Sprintf(message, version.Locked.Abs(version.Locked).String(), version.Symbol,
-			serverCfg.Name, *version.RefAddress, *version.RefTxID)
+		return fmt.Sprintf(message, FormatAmount(version.Symbol, fmath.Abs(version.Locked)), version.Symbol,
+			assetName(version.Symbol), *version.RefAddress, *version.RefTxID)
+	case models.ReasonTransferIn:
+		// 转账收入
+		message := Tr(fromID, "lng_history_transfer_in")
+		return fmt.Sprintf(message, *version.RefUserName, *version.RefUserID,
+			FormatAmount(version.Symbol, version.Balance), version.Symbol)
+	case models.ReasonTransferOut:
+		// 转账支出
+		message := Tr(fromID, "lng_history_transfer_out")
+		return fmt.Sprintf(message, FormatAmount(version.Symbol, fmath.Abs(version.Balance)), version.Symbol,
+			*version.RefUserName, *version.RefUserID)
+	case models.ReasonFreeze:
+		// 冻结账户
+		message := Tr(fromID, "lng_history_freeze")
+		return fmt.Sprintf(message, EscapeMarkdown(*version.RefMemo))
+	case models.ReasonUnfreeze:
+		// 解冻账户
+		message := Tr(fromID, "lng_history_unfreeze")
+		return fmt.Sprintf(message, EscapeMarkdown(*version.RefMemo))
+	case models.ReasonAdjustment:
+		// 人工调账
+		message := Tr(fromID, "lng_history_adjustment_credit")
+		if version.Balance.Sign() < 0 {
+			message = Tr(fromID, "lng_history_adjustment_debit")
+		}
+		return fmt.Sprintf(message, FormatAmount(version.Symbol, fmath.Abs(version.Balance)), version.Symbol,
+			EscapeMarkdown(*version.RefMemo))
 	}
 	return ""
 }
@@ -2,10 +2,7 @@ package handlers
 
 import (
 	"fmt"
-	"math/big"
 	"regexp"
-	"strconv"
-	"strings"
 
 	"github.com/zhangpanyi/basebot/history"
 	"github.com/zhangpanyi/basebot/logger"
@@ -13,11 +10,14 @@ import (
 	"github.com/zhangpanyi/basebot/telegram/types"
 	"luckybot/app/config"
 	"luckybot/app/fmath"
-	"luckybot/app/future"
 	"luckybot/app/logic/scriptengine"
+	"luckybot/app/logic/withdraw"
 	"luckybot/app/storage/models"
 )
 
+// 匹配资产
+var reMathWithdrawAsset *regexp.Regexp
+
 // 匹配金额
 var reMathWithdrawAmount *regexp.Regexp
 
@@ -29,17 +29,22 @@ var reMathWithdrawSubmit *regexp.Regexp
 
 func init() {
 	var err error
-	reMathWithdrawAmount, err = regexp.Compile("^/withdraw/([0-9]+\\.?[0-9]*)/$")
+	reMathWithdrawAsset, err = regexp.Compile("^/withdraw/(\\w+)/$")
 	if err != nil {
 		panic(err)
 	}
 
-	reMathWithdrawAccout, err = regexp.Compile("^/withdraw/([0-9]+\\.?[0-9]*)/(\\w+)/$")
+	reMathWithdrawAmount, err = regexp.Compile("^/withdraw/(\\w+)/([0-9]+\\.?[0-9]*)/$")
 	if err != nil {
 		panic(err)
 	}
 
-	reMathWithdrawSubmit, err = regexp.Compile("^/withdraw/([0-9]+\\.?[0-9]*)/([\\w|-]+)/submit/$")
+	reMathWithdrawAccout, err = regexp.Compile("^/withdraw/(\\w+)/([0-9]+\\.?[0-9]*)/(\\w+)/$")
+	if err != nil {
+		panic(err)
+	}
+
+	reMathWithdrawSubmit, err = regexp.Compile("^/withdraw/(\\w+)/([0-9]+\\.?[0-9]*)/([\\w|-]+)/submit/$")
 	if err != nil {
 		panic(err)
 	}
@@ -51,23 +56,51 @@ type WithdrawHandler struct {
 
 // 取款信息
 type withdrawInfo struct {
-	account string  // 账户名
-	amount  float64 // 资产数量
+	asset   string        // 资产类型
+	account string        // 账户名
+	amount  *fmath.Amount // 资产数量
 }
 
 // Handle 消息处理
 func (handler *WithdrawHandler) Handle(bot *methods.BotExt, r *history.History, update *types.Update) {
-	// 回复输入金额
+	// 检查账户冻结
+	if replyAccountFrozen(bot, r, update) {
+		return
+	}
+
+	// 回复选择资产
 	info := new(withdrawInfo)
 	data := update.CallbackQuery.Data
 	if data == "/withdraw/" {
+		r.Clear()
+		handler.replyChooseAsset(bot, update.CallbackQuery)
+		return
+	}
+
+	// 回复输入金额
+	serverCfg := config.GetServe()
+	result := reMathWithdrawAsset.FindStringSubmatch(data)
+	if len(result) == 2 {
+		if _, ok := serverCfg.GetAsset(result[1]); !ok {
+			return
+		}
+		info.asset = result[1]
 		handler.replyEnterWithdrawAmount(bot, r, info, update)
+		return
 	}
 
 	// 处理输入账户名
-	result := reMathWithdrawAmount.FindStringSubmatch(data)
-	if len(result) == 2 {
-		amount, _ := strconv.ParseFloat(result[1], 10)
+	result = reMathWithdrawAmount.FindStringSubmatch(data)
+	if len(result) == 3 {
+		asset, ok := serverCfg.GetAsset(result[1])
+		if !ok {
+			return
+		}
+		amount, err := fmath.Parse(result[2], asset.Precision)
+		if err != nil {
+			return
+		}
+		info.asset = result[1]
 		info.amount = amount
 		handler.replyEnterAccout(bot, r, info, update, true)
 		return
@@ -75,20 +108,36 @@ func (handler *WithdrawHandler) Handle(bot *methods.BotExt, r *history.History,
 
 	// 处理提现总览
 	result = reMathWithdrawAccout.FindStringSubmatch(data)
-	if len(result) == 3 {
-		amount, _ := strconv.ParseFloat(result[1], 10)
+	if len(result) == 4 {
+		asset, ok := serverCfg.GetAsset(result[1])
+		if !ok {
+			return
+		}
+		amount, err := fmath.Parse(result[2], asset.Precision)
+		if err != nil {
+			return
+		}
+		info.asset = result[1]
 		info.amount = amount
-		info.account = result[2]
+		info.account = result[3]
 		handler.replyWithdrawOverview(bot, r, info, update, true)
 		return
 	}
 
 	// 处理提现请求
 	result = reMathWithdrawSubmit.FindStringSubmatch(data)
-	if len(result) == 3 {
-		amount, _ := strconv.ParseFloat(result[1], 10)
+	if len(result) == 4 {
+		asset, ok := serverCfg.GetAsset(result[1])
+		if !ok {
+			return
+		}
+		amount, err := fmath.Parse(result[2], asset.Precision)
+		if err != nil {
+			return
+		}
+		info.asset = result[1]
 		info.amount = amount
-		info.account = result[2]
+		info.account = result[3]
 		handler.handleWithdraw(bot, r, info, update.CallbackQuery)
 		return
 	}
@@ -99,6 +148,15 @@ func (handler *WithdrawHandler) route(bot *methods.BotExt, query *types.Callback
 	return nil
 }
 
+// 回复选择资产
+func (handler *WithdrawHandler) replyChooseAsset(bot *methods.BotExt, query *types.CallbackQuery) {
+	fromID := query.From.ID
+	reply := tr(fromID, "lng_withdraw_choose_asset")
+	markup := makeAssetMenus(fromID, query.Data, "/main/")
+	_ = bot.AnswerCallbackQuery(query, "", false, "", 0)
+	_, _ = bot.EditMessageReplyMarkup(query.Message, reply, true, markup)
+}
+
 // 处理输入提现金额
 func (handler *WithdrawHandler) handleEnterWithdrawAmount(bot *methods.BotExt, r *history.History, info *withdrawInfo,
 	update *types.Update, amount string) {
@@ -112,7 +170,7 @@ func (handler *WithdrawHandler) handleEnterWithdrawAmount(bot *methods.BotExt, r
 		menus := [...]methods.InlineKeyboardButton{
 			methods.InlineKeyboardButton{
 				Text:         tr(fromID, "lng_back_superior"),
-				CallbackData: "/main/",
+				CallbackData: backSuperior(data),
 			},
 		}
 		_ = bot.AnswerCallbackQuery(query, "", false, "", 0)
@@ -121,43 +179,39 @@ func (handler *WithdrawHandler) handleEnterWithdrawAmount(bot *methods.BotExt, r
 	}
 
 	// 获取账户余额
-	balance := big.NewFloat(0)
 	serverCfg := config.GetServe()
-	model := models.AccountModel{}
-	account, err := model.GetAccount(fromID, serverCfg.Symbol)
-	if err == nil {
-		balance = account.Amount
-	}
+	asset, _ := serverCfg.GetAsset(info.asset)
+	balance, _ := getUserBalance(fromID, asset.Symbol)
 
 	// 检查输入金额
-	result := strings.Split(amount, ".")
-	fee := big.NewFloat(serverCfg.WithdrawFee)
-	if len(result) == 2 && len(result[1]) > 2 {
+	fee := asset.FeeAmount()
+	value, err := fmath.Parse(amount, asset.Precision)
+	if err != nil || value.Sign() <= 0 {
 		reply := tr(fromID, "lng_withdraw_amount_not_enough")
-		handlerError(fmt.Sprintf(reply, balance.String(),
-			serverCfg.Symbol, fee.String(), serverCfg.Symbol))
+		handlerError(fmt.Sprintf(reply, balance.Format(asset.Precision),
+			asset.Symbol, fee.Format(asset.Precision), asset.Symbol))
 		return
 	}
 
-	fAmount, err := strconv.ParseFloat(amount, 10)
-	if err != nil {
-		reply := tr(fromID, "lng_withdraw_amount_not_enough")
-		handlerError(fmt.Sprintf(reply, balance.String(),
-			serverCfg.Symbol, fee.String(), serverCfg.Symbol))
+	// 检查最小金额
+	minWithdraw := asset.MinWithdrawAmount()
+	if value.Cmp(minWithdraw) == -1 {
+		reply := tr(fromID, "lng_withdraw_amount_too_little")
+		handlerError(fmt.Sprintf(reply, minWithdraw.Format(asset.Precision), asset.Symbol))
 		return
 	}
 
 	// 检查用户余额
-	if err != nil || account.Amount.Cmp(fmath.Add(big.NewFloat(fAmount), fee)) == -1 {
+	if balance.Cmp(fmath.Add(value, fee)) == -1 {
 		reply := tr(fromID, "lng_withdraw_amount_error")
-		handlerError(fmt.Sprintf(reply, balance.String(),
-			serverCfg.Symbol, fee.String(), serverCfg.Symbol))
+		handlerError(fmt.Sprintf(reply, balance.Format(asset.Precision),
+			asset.Symbol, fee.Format(asset.Precision), asset.Symbol))
 		return
 	}
 
 	// 更新下个操作状态
 	r.Clear()
-	info.amount = fAmount
+	info.amount = value
 	update.CallbackQuery.Data = data + amount + "/"
 	handler.replyEnterAccout(bot, r, info, update, false)
 }
@@ -180,29 +234,25 @@ func (handler *WithdrawHandler) replyEnterWithdrawAmount(bot *methods.BotExt, r
 	menus := [...]methods.InlineKeyboardButton{
 		methods.InlineKeyboardButton{
 			Text:         tr(fromID, "lng_back_superior"),
-			CallbackData: "/main/",
+			CallbackData: backSuperior(query.Data),
 		},
 	}
 	markup := methods.MakeInlineKeyboardMarkupAuto(menus[:], 1)
 
 	// 获取账户余额
-	balance := big.NewFloat(0)
 	serverCfg := config.GetServe()
-	model := models.AccountModel{}
-	account, err := model.GetAccount(fromID, serverCfg.Symbol)
-	if err == nil {
-		balance = account.Amount
-	}
+	asset, _ := serverCfg.GetAsset(info.asset)
+	balance, _ := getUserBalance(fromID, asset.Symbol)
 
 	// 回复提现操作提示
-	fee := big.NewFloat(serverCfg.WithdrawFee)
+	fee := asset.FeeAmount()
 	reply := tr(fromID, "lng_withdraw_enter_amount")
-	reply = fmt.Sprintf(reply, balance.String(),
-		serverCfg.Symbol, fee.String(), serverCfg.Symbol)
+	reply = fmt.Sprintf(reply, balance.Format(asset.Precision),
+		asset.Symbol, fee.Format(asset.Precision), asset.Symbol)
 	_, _ = bot.EditMessageReplyMarkup(query.Message, reply, true, markup)
 
 	answer := tr(fromID, "lng_withdraw_enter_amount_answer")
-	answer = fmt.Sprintf(answer, serverCfg.Symbol)
+	answer = fmt.Sprintf(answer, asset.Symbol)
 	_ = bot.AnswerCallbackQuery(query, answer, false, "", 0)
 }
 
@@ -228,7 +278,7 @@ func (handler *WithdrawHandler) handleEnterWithdrawAccout(bot *methods.BotExt, r
 	}
 
 	// 检查帐号合法
-	if !scriptengine.Engine.ValidAddress(account) {
+	if !scriptengine.Engine().ValidAddress(account, info.asset) {
 		handlerError(tr(fromID, "lng_withdraw_account_error"))
 		return
 	}
@@ -265,8 +315,9 @@ func (handler *WithdrawHandler) replyEnterAccout(bot *methods.BotExt, r *history
 	// 回复请求结果
 	r.Clear().Push(update)
 	serverCfg := config.GetServe()
+	asset, _ := serverCfg.GetAsset(info.asset)
 	reply := tr(fromID, "lng_withdraw_enter_account")
-	reply = fmt.Sprintf(reply, big.NewFloat(info.amount).String(), serverCfg.Symbol, serverCfg.Name)
+	reply = fmt.Sprintf(reply, info.amount.Format(asset.Precision), asset.Symbol, asset.Name)
 	if !edit {
 		_, _ = bot.SendMessage(fromID, reply, true, markup)
 	} else {
@@ -288,11 +339,12 @@ func (handler *WithdrawHandler) replyWithdrawOverview(bot *methods.BotExt, r *hi
 
 	// 格式化信息
 	serverCfg := config.GetServe()
-	amount := big.NewFloat(info.amount)
-	fee := big.NewFloat(serverCfg.WithdrawFee)
+	asset, _ := serverCfg.GetAsset(info.asset)
+	amount := info.amount.Format(asset.Precision)
+	fee := asset.FeeAmount().Format(asset.Precision)
 	reply := tr(fromID, "lng_withdraw_overview")
-	reply = fmt.Sprintf(reply, info.account, amount.String(), serverCfg.Symbol,
-		amount.String(), fee.String(), serverCfg.Symbol, fee.String(), serverCfg.Symbol)
+	reply = fmt.Sprintf(reply, info.account, amount, asset.Symbol,
+		amount, fee, asset.Symbol, fee, asset.Symbol)
 
 	// 生成菜单按钮
 	menus := [...]methods.InlineKeyboardButton{
@@ -331,94 +383,39 @@ func (handler *WithdrawHandler) handleWithdraw(bot *methods.BotExt, r *history.H
 
 	// 获取手续费
 	serverCfg := config.GetServe()
-	fee := big.NewFloat(serverCfg.WithdrawFee)
-
-	// 扣除余额
-	model := models.AccountModel{}
-	amount := big.NewFloat(info.amount)
-	account, err := model.LockAccount(fromID, serverCfg.Symbol, fmath.Add(amount, fee))
+	asset, _ := serverCfg.GetAsset(info.asset)
+	fee := asset.FeeAmount()
+
+	amount := info.amount
+	// 创建提现记录并锁定余额
+	withdrawalModel := models.WithdrawalModel{}
+	withdrawal, err := withdrawalModel.NewWithdrawal(&models.Withdrawal{
+		UserID:  fromID,
+		Symbol:  asset.Symbol,
+		Address: info.account,
+		Amount:  amount,
+		Fee:     fee,
+	})
 	if err != nil {
-		logger.Warnf("Failed to withdraw, user: %d, asset: %s, amount: %s, fee: %s, %v",
-			fromID, serverCfg.Symbol, amount.String(), fee.String(), err)
-		reply := tr(fromID, "lng_withdraw_not_enough")
+		logger.Warnf("Failed to new withdrawal, user: %d, asset: %s, amount: %s, fee: %s, %v",
+			fromID, asset.Symbol, amount.Format(asset.Precision), fee.Format(asset.Precision), err)
+		reply := tr(fromID, "lng_withdraw_transfer_error")
+		if err == models.ErrInsufficientAmount || err == models.ErrNoSuchTypeAccount {
+			reply = tr(fromID, "lng_withdraw_not_enough")
+		}
 		_ = bot.AnswerCallbackQuery(query, reply, false, "", 0)
 		_, _ = bot.EditMessageReplyMarkup(query.Message, reply, false, markup)
 		return
 	}
-	logger.Errorf("Withdraw submitted, user: %d, asset: %s, amount: %s, fee: %s",
-		fromID, serverCfg.Symbol, amount.String(), fee.String())
+	logger.Errorf("Withdraw submitted, id: %d, user: %d, asset: %s, amount: %s, fee: %s",
+		withdrawal.ID, fromID, asset.Symbol, amount.Format(asset.Precision), fee.Format(asset.Precision))
 
 	// 提交成功
 	reply := tr(fromID, "lng_withdraw_submit_ok")
 	answer := tr(fromID, "lng_withdraw_submit_ok_answer")
 	_ = bot.AnswerCallbackQuery(query, answer, false, "", 0)
-	_, _ = bot.EditMessageReplyMarkup(query.Message, reply, true, nil)
-
-	// 记录账户历史
-	versionModel := models.AccountVersionModel{}
-	_, _ = versionModel.InsertVersion(fromID, &models.Version{
-		Symbol:     serverCfg.Symbol,
-		Locked:     amount,
-		Fee:        fee,
-		Amount:     account.Amount,
-		Reason:     models.ReasonWithdraw,
-		RefAddress: &info.account,
-	})
-
-	// 开始转账提示
-	reply = tr(fromID, "lng_withdraw_agreed")
-	_, _ = bot.EditMessageReplyMarkup(query.Message, reply, true, nil)
+	_, _ = bot.EditMessageReplyMarkup(query.Message, reply, true, markup)
 
 	// 执行提现操作
-	zero := big.NewFloat(0)
-	f := future.Manager.NewFuture()
-	go scriptengine.Engine.OnWithdraw(info.account, serverCfg.Symbol, amount.String(), f.ID())
-	txid, err := f.GetResult()
-	if err != nil {
-		// 解锁资产
-		account, err := model.UnlockAccount(fromID, serverCfg.Symbol, fmath.Add(amount, fee))
-		if err == nil {
-			_, _ = versionModel.InsertVersion(fromID, &models.Version{
-				Symbol:     serverCfg.Symbol,
-				Locked:     fmath.Sub(zero, amount),
-				Fee:        fee,
-				Amount:     account.Amount,
-				Reason:     models.ReasonWithdrawFailure,
-				RefAddress: &info.account,
-			})
-		} else {
-			logger.Warnf(`Failed to unlock account when withdraw failure, user: %d, asset: %s, \
-			amount: %s, fee: %s, %v`, fromID, serverCfg.Symbol, amount.String(), fee.String(), err)
-		}
-
-		// 返回信息
-		reply := tr(fromID, "lng_withdraw_transfer_error")
-		logger.Warnf("Failed to transfer, user: %d, asset: %s, amount: %s, fee: %s, %v",
-			fromID, serverCfg.Symbol, amount.String(), fee.String(), err)
-		_, _ = bot.EditMessageReplyMarkup(query.Message, reply, false, markup)
-		return
-	}
-
-	// 记录提现成功
-	account, err = model.Withdraw(fromID, serverCfg.Symbol, fmath.Add(amount, fee))
-	if err == nil {
-		_, _ = versionModel.InsertVersion(fromID, &models.Version{
-			Symbol:     serverCfg.Symbol,
-			Balance:    fmath.Sub(zero, fmath.Add(amount, fee)),
-			Locked:     fmath.Sub(zero, amount),
-			Fee:        fee,
-			Amount:     account.Amount,
-			Reason:     models.ReasonWithdrawSuccess,
-			RefAddress: &info.account,
-			RefTxID:    &txid,
-		})
-	} else {
-		logger.Warnf(`Failed to unlock account when withdraw success, user: %d, asset: %s, \
-		amount: %s, fee: %s, %v`, fromID, serverCfg.Symbol, amount.String(), fee.String(), err)
-	}
-
-	// 返回处理结果
-	reply = tr(fromID, "lng_withdraw_success")
-	_, _ = bot.EditMessageReplyMarkup(query.Message, fmt.Sprintf(reply, txid), true, markup)
-	return
+	withdraw.Submit(withdrawal.ID)
 }
@@ -1,9 +1,12 @@
 package logic
 
 import (
+	"strings"
+
 	"github.com/zhangpanyi/basebot/logger"
 	"github.com/zhangpanyi/basebot/telegram/methods"
 	"github.com/zhangpanyi/basebot/telegram/types"
+	"luckybot/app/config"
 	"luckybot/app/logic/context"
 	"luckybot/app/logic/handlers"
 	"luckybot/app/storage/models"
@@ -13,6 +16,8 @@ import (
 func NewUpdate(bot *methods.BotExt, update *types.Update) {
 	// 展示红包
 	if update.InlineQuery != nil {
+		initLanguage(update.InlineQuery.From.ID, update.InlineQuery.From.LanguageCode)
+		updateProfile(update.InlineQuery.From)
 		handlers.ShowLuckyMoney(bot, update.InlineQuery)
 		return
 	}
@@ -22,12 +27,17 @@ func NewUpdate(bot *methods.BotExt, update *types.Update) {
 	if update.Message != nil {
 		fromID = update.Message.From.ID
 		if update.Message.Chat.Type != types.ChatPrivate {
+			handleGroupMessage(bot, update.Message)
 			return
 		}
 
 		// 添加订户
 		model := models.SubscriberModel{}
 		_ = model.AddSubscriber(fromID)
+
+		// 初始化用户语言
+		initLanguage(fromID, update.Message.From.LanguageCode)
+		updateProfile(update.Message.From)
 	} else if update.CallbackQuery != nil {
 		fromID = update.CallbackQuery.From.ID
 	} else {
@@ -41,8 +51,12 @@ func NewUpdate(bot *methods.BotExt, update *types.Update) {
 		return
 	}
 
-	// 领取红包
+	// 领取红包或确认转账
 	if update.CallbackQuery != nil && update.CallbackQuery.InlineMessageID != nil {
+		if strings.HasPrefix(update.CallbackQuery.Data, "/transfer/") {
+			new(handlers.TransferHandler).Handle(bot, r, update)
+			return
+		}
 		new(handlers.ReceiveHandler).Handle(bot, r, update)
 		return
 	}
@@ -55,3 +69,60 @@ func NewUpdate(bot *methods.BotExt, update *types.Update) {
 		context.DelRecord(uint32(fromID))
 	}
 }
+
+// 初始化用户语言
+func initLanguage(userID int64, languageCode string) {
+	code, ok := config.GetLanguge().Match(languageCode)
+	if !ok {
+		return
+	}
+	model := models.UserModel{}
+	if err := model.InitLanguage(userID, code); err != nil {
+		logger.Warnf("Failed to init user language, %v, %v", userID, err)
+	}
+}
+
+// 更新用户资料
+func updateProfile(user *types.User) {
+	model := models.UserModel{}
+	if err := model.UpdateProfile(user.ID, user.FirstName, user.UserName); err != nil {
+		logger.Warnf("Failed to update user profile, %v, %v", user.ID, err)
+	}
+}
+
+// 处理群组消息
+func handleGroupMessage(bot *methods.BotExt, message *types.Message) {
+	if message.From == nil || message.Chat == nil {
+		return
+	}
+	if message.Chat.Type != types.ChatGroup && message.Chat.Type != types.SuperGroup {
+		return
+	}
+
+	// 记录群组成员
+	model := models.ChatMemberModel{}
+	if err := model.AddMember(message.Chat.ID, message.From.ID); err != nil {
+		logger.Warnf("Failed to add chat member, %v, %v, %v", message.Chat.ID, message.From.ID, err)
+	}
+
+	// 查询群组ID
+	if message.Text == "/chatid" || message.Text == "/chatid@"+bot.UserName {
+		handlers.ReplyChatID(bot, message)
+		return
+	}
+
+	// 绑定首发群组
+	handlers.BindPostedLuckyMoney(message)
+
+	// 匹配红包口令
+	luckyMoneyModel := models.LuckyMoneyModel{}
+	ids, err := luckyMoneyModel.UnlockByPassword(message.Chat.ID, message.From.ID, message.Text)
+	if err != nil {
+		logger.Warnf("Failed to unlock lucky money by password, %v, %v, %v", message.Chat.ID, message.From.ID, err)
+		return
+	}
+	if len(ids) > 0 {
+		logger.Infof("Unlock lucky money by password, chat_id: %d, user_id: %d, ids: %v",
+			message.Chat.ID, message.From.ID, ids)
+	}
+}
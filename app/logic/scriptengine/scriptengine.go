@@ -1,22 +1,124 @@
 package scriptengine
 
 import (
+	"path/filepath"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/fsnotify/fsnotify"
 	"github.com/zhangpanyi/basebot/logger"
+	"luckybot/app/config"
 	"luckybot/app/luaglue"
+	"luckybot/app/storage/models"
 )
 
+// 重新加载延迟, 合并连续的文件变更
+const reloadDelay = 500 * time.Millisecond
+
 var once sync.Once
-var Engine *luaglue.LuaGlue
+var engine atomic.Value
+
+// Engine 获取当前脚本引擎
+func Engine() *luaglue.LuaGlue {
+	glue, _ := engine.Load().(*luaglue.LuaGlue)
+	return glue
+}
+
+// 脚本引擎选项
+func makeOptions() luaglue.Options {
+	serveCfg := config.GetServe()
+	return luaglue.Options{
+		PoolSize:        serveCfg.Script.PoolSize,
+		Timeout:         time.Duration(serveCfg.Script.Timeout) * time.Millisecond,
+		CallStackSize:   serveCfg.Script.CallStackSize,
+		RegistryMaxSize: serveCfg.Script.RegistryMaxSize,
+	}
+}
 
 // NewScriptEngineOnce 创建脚本引擎
 func NewScriptEngineOnce() {
 	once.Do(func() {
-		var err error
-		Engine, err = luaglue.NewLuaGlue()
+		glue, err := luaglue.NewLuaGlue(makeOptions())
 		if err != nil {
 			logger.Panic(err)
 		}
+		engine.Store(glue)
+
+		watcher, err := fsnotify.NewWatcher()
+		if err != nil {
+			logger.Panic(err)
+		}
+		if err = watcher.Add(luaglue.ScriptDir); err != nil {
+			logger.Panic(err)
+		}
+		go watch(watcher)
 	})
 }
+
+// 观察脚本变更
+func watch(watcher *fsnotify.Watcher) {
+	timer := time.NewTimer(reloadDelay)
+	timer.Stop()
+	for {
+		select {
+		case evt := <-watcher.Events:
+			if strings.ToLower(filepath.Ext(evt.Name)) != ".lua" {
+				continue
+			}
+			if evt.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename|fsnotify.Remove) != 0 {
+				timer.Reset(reloadDelay)
+			}
+		case err := <-watcher.Errors:
+			logger.Warnf("Script notify: recv error event, %v", err)
+		case <-timer.C:
+			reload()
+		}
+	}
+}
+
+// 重新加载脚本, 加载失败时继续使用旧引擎
+func reload() {
+	glue, err := luaglue.NewLuaGlue(makeOptions())
+	if err != nil {
+		logger.Warnf("Script notify: reload failed, keep running the old scripts, %v", err)
+		return
+	}
+
+	old := Engine()
+	engine.Store(glue)
+	logger.Infof("Script notify: reload scripts finished")
+	if old != nil {
+		go old.Shutdown()
+	}
+}
+
+// LuckyMoneyEvent 生成红包事件参数, ID和金额均使用字符串
+func LuckyMoneyEvent(luckyMoney *models.LuckyMoney) map[string]interface{} {
+	serveCfg := config.GetServe()
+	precision := serveCfg.Precision(luckyMoney.Asset)
+	event := map[string]interface{}{
+		"id":          strconv.FormatUint(luckyMoney.ID, 10),
+		"sn":          luckyMoney.SN,
+		"sender_id":   strconv.FormatInt(luckyMoney.SenderID, 10),
+		"sender_name": luckyMoney.SenderName,
+		"asset":       luckyMoney.Asset,
+		"amount":      luckyMoney.Amount.Format(precision),
+		"number":      float64(luckyMoney.Number),
+		"lucky":       luckyMoney.Lucky,
+		"message":     luckyMoney.Message,
+		"timestamp":   float64(luckyMoney.Timestamp),
+		"scope":       luckyMoney.Scope,
+		"activate_at": float64(luckyMoney.ActivateAt),
+		"expire_at":   float64(luckyMoney.ExpireAt),
+		"distributor": luckyMoney.Distributor,
+		"password":    luckyMoney.Password != "",
+		"exclusive":   luckyMoney.RecipientID != 0 || luckyMoney.RecipientName != "",
+	}
+	if luckyMoney.Value != nil {
+		event["value"] = luckyMoney.Value.Format(precision)
+	}
+	return event
+}
@@ -0,0 +1,186 @@
+package withdraw
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/zhangpanyi/basebot/logger"
+	"luckybot/app/future"
+	"luckybot/app/logic/handlers/utils"
+	"luckybot/app/logic/pusher"
+	"luckybot/app/logic/scriptengine"
+	"luckybot/app/storage"
+	"luckybot/app/storage/models"
+)
+
+var once sync.Once
+var worker *Worker
+
+// 提现结果未知
+var errResultUnknown = errors.New("withdrawal result unknown")
+
+// 等待提现结果超时时间
+const resultTimeout = time.Minute
+
+// ErrProcessing 提现正在处理
+var ErrProcessing = errors.New("withdrawal is processing")
+
+// Worker 提现处理器
+type Worker struct {
+	processing sync.Map
+}
+
+// StartWorkerOnce 启动提现处理器
+func StartWorkerOnce() {
+	once.Do(func() {
+		worker = &Worker{}
+
+		// 恢复未完成提现
+		ids := make([]uint64, 0)
+		model := models.WithdrawalModel{}
+		err := model.Foreach(func(withdrawal *models.Withdrawal) {
+			switch withdrawal.State {
+			case models.WithdrawStatePending:
+				ids = append(ids, withdrawal.ID)
+			case models.WithdrawStateSubmitted:
+				logger.Warnf("Withdrawal result unknown, waiting for manual retry or refund, id: %d, user: %d, asset: %s, amount: %s",
+					withdrawal.ID, withdrawal.UserID, withdrawal.Symbol, utils.FormatAmount(withdrawal.Symbol, withdrawal.Amount))
+			}
+		})
+		if err != nil && !errors.Is(err, storage.ErrNoBucket) {
+			logger.Panic(err)
+		}
+
+		for _, id := range ids {
+			Submit(id)
+		}
+	})
+}
+
+// Submit 提交提现
+func Submit(id uint64) {
+	go worker.process(id)
+}
+
+// Retry 重试提现, 正在处理的提现不能重试
+func Retry(id uint64) error {
+	if !worker.acquire(id) {
+		return ErrProcessing
+	}
+	model := models.WithdrawalModel{}
+	_, err := model.SetState(id, []models.WithdrawState{models.WithdrawStateSubmitted},
+		models.WithdrawStatePending, "", "")
+	worker.release(id)
+	if err != nil {
+		return err
+	}
+	Submit(id)
+	return nil
+}
+
+// Refund 退还提现, 正在处理的提现不能退还
+func Refund(id uint64, reason string) error {
+	if !worker.acquire(id) {
+		return ErrProcessing
+	}
+	defer worker.release(id)
+	return worker.refund(id, []models.WithdrawState{models.WithdrawStatePending,
+		models.WithdrawStateSubmitted}, reason)
+}
+
+// 占用提现, 同一提现同时只能有一个操作
+func (w *Worker) acquire(id uint64) bool {
+	_, loaded := w.processing.LoadOrStore(id, true)
+	return !loaded
+}
+
+// 释放提现
+func (w *Worker) release(id uint64) {
+	w.processing.Delete(id)
+}
+
+// 处理提现
+func (w *Worker) process(id uint64) {
+	if !w.acquire(id) {
+		return
+	}
+	defer w.release(id)
+
+	// 更新提现状态
+	model := models.WithdrawalModel{}
+	withdrawal, err := model.SetState(id, []models.WithdrawState{models.WithdrawStatePending},
+		models.WithdrawStateSubmitted, "", "")
+	if err != nil {
+		logger.Warnf("Failed to submit withdrawal, id: %d, %v", id, err)
+		return
+	}
+
+	// 执行提现操作
+	f := future.Manager.NewFuture()
+	amount := utils.FormatAmount(withdrawal.Symbol, withdrawal.Amount)
+	fee := utils.FormatAmount(withdrawal.Symbol, withdrawal.Fee)
+	go func() {
+		// 脚本调用失败或者返回时未设置结果, 无法确定是否已转账, 等待人工处理
+		err := scriptengine.Engine().OnWithdraw(withdrawal.Address, withdrawal.Symbol, amount, f.ID())
+		if err != nil {
+			future.Manager.SetResult(f.ID(), "", fmt.Errorf("%w, %v", errResultUnknown, err))
+			return
+		}
+		future.Manager.SetResult(f.ID(), "", fmt.Errorf("%w, on_withdraw returned without result", errResultUnknown))
+	}()
+	txid, err := f.GetResultTimeout(resultTimeout)
+	if err == future.ErrTimeout {
+		future.Manager.Remove(f.ID())
+		err = fmt.Errorf("%w, %v", errResultUnknown, err)
+	}
+	if errors.Is(err, errResultUnknown) {
+		logger.Errorf("Withdrawal result unknown, waiting for manual retry or refund, id: %d, user: %d, asset: %s, amount: %s, %v",
+			id, withdrawal.UserID, withdrawal.Symbol, amount, err)
+		return
+	}
+	if err != nil {
+		logger.Warnf("Failed to transfer, id: %d, user: %d, asset: %s, amount: %s, fee: %s, %v",
+			id, withdrawal.UserID, withdrawal.Symbol, amount, fee, err)
+		if err = w.refund(id, []models.WithdrawState{models.WithdrawStateSubmitted}, err.Error()); err != nil {
+			logger.Errorf("Failed to refund withdrawal, id: %d, %v", id, err)
+		}
+		return
+	}
+	w.confirm(id, txid)
+}
+
+// 确认提现
+func (w *Worker) confirm(id uint64, txid string) {
+	model := models.WithdrawalModel{}
+	withdrawal, version, err := model.Confirm(id, txid)
+	if err != nil {
+		logger.Errorf("Failed to confirm withdrawal, id: %d, txid: %s, %v", id, txid, err)
+		return
+	}
+	logger.Errorf("Withdraw success, id: %d, user: %d, asset: %s, amount: %s, fee: %s, txid: %s",
+		id, withdrawal.UserID, withdrawal.Symbol,
+		utils.FormatAmount(withdrawal.Symbol, withdrawal.Amount),
+		utils.FormatAmount(withdrawal.Symbol, withdrawal.Fee), txid)
+
+	// 推送提现通知
+	pusher.Post(withdrawal.UserID, utils.MakeHistoryMessage(withdrawal.UserID, version), true, nil)
+}
+
+// 退还提现
+func (w *Worker) refund(id uint64, from []models.WithdrawState, reason string) error {
+	model := models.WithdrawalModel{}
+	withdrawal, version, err := model.Refund(id, from, reason)
+	if err != nil {
+		return err
+	}
+	logger.Errorf("Withdraw refunded, id: %d, user: %d, asset: %s, amount: %s, fee: %s, reason: %s",
+		id, withdrawal.UserID, withdrawal.Symbol,
+		utils.FormatAmount(withdrawal.Symbol, withdrawal.Amount),
+		utils.FormatAmount(withdrawal.Symbol, withdrawal.Fee), reason)
+
+	// 推送提现通知
+	pusher.Post(withdrawal.UserID, utils.MakeHistoryMessage(withdrawal.UserID, version), true, nil)
+	return nil
+}
@@ -0,0 +1,72 @@
+package luaglue
+
+import (
+	"errors"
+	"sync/atomic"
+
+	lua "github.com/yuin/gopher-lua"
+	"github.com/zhangpanyi/basebot/logger"
+)
+
+// Deposit 充值信息
+type Deposit struct {
+	TxID   string // 交易ID
+	Height uint64 // 区块高度
+	From   string // 来源地址
+	To     string // 目标地址
+	Asset  string // 资产名称
+	Amount string // 充值金额
+	Memo   string // 备注信息
+}
+
+// DepositCreditor 充值入账处理器, validate用于验证交易是否有效
+type DepositCreditor func(deposit *Deposit, validate func(*Deposit) bool) error
+
+var creditor atomic.Value
+
+// SetDepositCreditor 设置充值入账处理器
+func SetDepositCreditor(fn DepositCreditor) {
+	creditor.Store(fn)
+}
+
+// BotLoader 加载模块
+func BotLoader(state *lua.LState) int {
+	mod := state.SetFuncs(state.NewTable(), map[string]lua.LGFunction{
+		"credit_deposit": botCreditDeposit,
+	})
+	state.Push(mod)
+	return 1
+}
+
+// 充值入账, 返回是否成功和错误信息
+func botCreditDeposit(state *lua.LState) int {
+	deposit := Deposit{
+		TxID:   state.CheckString(1),
+		Height: uint64(state.CheckNumber(2)),
+		From:   state.CheckString(3),
+		To:     state.CheckString(4),
+		Asset:  state.CheckString(5),
+		Amount: state.CheckString(6),
+		Memo:   state.CheckString(7),
+	}
+
+	fn, ok := creditor.Load().(DepositCreditor)
+	if !ok || fn == nil {
+		state.Push(lua.LFalse)
+		pushError(state, errors.New("deposit creditor not set"))
+		return 2
+	}
+
+	// 在当前状态中验证交易, 避免占用其它状态
+	err := fn(&deposit, func(deposit *Deposit) bool {
+		valid, err := validTransaction(state, deposit.TxID, deposit.From, deposit.To,
+			deposit.Asset, deposit.Amount, deposit.Memo)
+		if err != nil {
+			logger.Warnf("Script: failed to call valid_transaction, txid: %s, %v", deposit.TxID, err)
+		}
+		return valid
+	})
+	state.Push(lua.LBool(err == nil))
+	pushError(state, err)
+	return 2
+}
@@ -0,0 +1,165 @@
+package luaglue
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"math/big"
+
+	lua "github.com/yuin/gopher-lua"
+)
+
+// base58字母表
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// CryptoLoader 加载模块
+func CryptoLoader(state *lua.LState) int {
+	mod := state.SetFuncs(state.NewTable(), map[string]lua.LGFunction{
+		"sha256":        cryptoSHA256,
+		"sha512":        cryptoSHA512,
+		"hmac_sha256":   cryptoHMACSHA256,
+		"hmac_sha512":   cryptoHMACSHA512,
+		"hex_encode":    cryptoHexEncode,
+		"hex_decode":    cryptoHexDecode,
+		"base58_encode": cryptoBase58Encode,
+		"base58_decode": cryptoBase58Decode,
+	})
+	state.Push(mod)
+	return 1
+}
+
+// 输出摘要, 第二个参数为true时返回原始字节, 否则返回十六进制字符串
+func pushDigest(state *lua.LState, digest []byte, raw bool) int {
+	if raw {
+		state.Push(lua.LString(digest))
+	} else {
+		state.Push(lua.LString(hex.EncodeToString(digest)))
+	}
+	return 1
+}
+
+// 计算摘要
+func digest(state *lua.LState, newHash func() hash.Hash) int {
+	data := state.CheckString(1)
+	h := newHash()
+	h.Write([]byte(data))
+	return pushDigest(state, h.Sum(nil), state.OptBool(2, false))
+}
+
+// 计算HMAC
+func hmacDigest(state *lua.LState, newHash func() hash.Hash) int {
+	key := state.CheckString(1)
+	data := state.CheckString(2)
+	mac := hmac.New(newHash, []byte(key))
+	mac.Write([]byte(data))
+	return pushDigest(state, mac.Sum(nil), state.OptBool(3, false))
+}
+
+// SHA256摘要
+func cryptoSHA256(state *lua.LState) int {
+	return digest(state, sha256.New)
+}
+
+// SHA512摘要
+func cryptoSHA512(state *lua.LState) int {
+	return digest(state, sha512.New)
+}
+
+// HMAC-SHA256签名
+func cryptoHMACSHA256(state *lua.LState) int {
+	return hmacDigest(state, sha256.New)
+}
+
+// HMAC-SHA512签名
+func cryptoHMACSHA512(state *lua.LState) int {
+	return hmacDigest(state, sha512.New)
+}
+
+// 十六进制编码
+func cryptoHexEncode(state *lua.LState) int {
+	state.Push(lua.LString(hex.EncodeToString([]byte(state.CheckString(1)))))
+	return 1
+}
+
+// 十六进制解码
+func cryptoHexDecode(state *lua.LState) int {
+	data, err := hex.DecodeString(state.CheckString(1))
+	if err != nil {
+		state.Push(lua.LNil)
+		state.Push(lua.LString(err.Error()))
+		return 2
+	}
+	state.Push(lua.LString(data))
+	state.Push(lua.LNil)
+	return 2
+}
+
+// base58编码
+func cryptoBase58Encode(state *lua.LState) int {
+	state.Push(lua.LString(base58Encode([]byte(state.CheckString(1)))))
+	return 1
+}
+
+// base58解码
+func cryptoBase58Decode(state *lua.LState) int {
+	data, err := base58Decode(state.CheckString(1))
+	if err != nil {
+		state.Push(lua.LNil)
+		state.Push(lua.LString(err.Error()))
+		return 2
+	}
+	state.Push(lua.LString(data))
+	state.Push(lua.LNil)
+	return 2
+}
+
+// base58编码, 前导零字节编码为字符1
+func base58Encode(data []byte) string {
+	num := new(big.Int).SetBytes(data)
+	radix := big.NewInt(58)
+	mod := new(big.Int)
+	result := make([]byte, 0, len(data)*138/100+1)
+	for num.Sign() > 0 {
+		num.DivMod(num, radix, mod)
+		result = append(result, base58Alphabet[mod.Int64()])
+	}
+	for _, b := range data {
+		if b != 0 {
+			break
+		}
+		result = append(result, base58Alphabet[0])
+	}
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+	return string(result)
+}
+
+// base58解码
+func base58Decode(text string) ([]byte, error) {
+	num := new(big.Int)
+	radix := big.NewInt(58)
+	for i := 0; i < len(text); i++ {
+		index := -1
+		for j := 0; j < len(base58Alphabet); j++ {
+			if base58Alphabet[j] == text[i] {
+				index = j
+				break
+			}
+		}
+		if index < 0 {
+			return nil, errors.New("invalid base58 character")
+		}
+		num.Mul(num, radix)
+		num.Add(num, big.NewInt(int64(index)))
+	}
+
+	zeros := 0
+	for zeros < len(text) && text[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+	return append(make([]byte, zeros), num.Bytes()...), nil
+}
@@ -2,6 +2,7 @@ package luaglue
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"net/http"
 	"strconv"
@@ -19,10 +20,26 @@ func HttpLoader(state *lua.LState) int {
 	return 1
 }
 
+// 发送请求, 调用超时后取消
+func doRequest(state *lua.LState, method, url, contentType string, body io.Reader) (*http.Response, error) {
+	ctx := state.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	if len(contentType) > 0 {
+		req.Header.Set("Content-Type", contentType)
+	}
+	return http.DefaultClient.Do(req)
+}
+
 // GET方法
 func get(state *lua.LState) int {
 	url := state.CheckString(-1)
-	resp, err := http.Get(url)
+	resp, err := doRequest(state, http.MethodGet, url, "", nil)
 	if err != nil {
 		state.Push(lua.LNil)
 		state.Push(lua.LString(err.Error()))
@@ -38,7 +55,7 @@ func post(state *lua.LState) int {
 	url := state.CheckString(-3)
 	contentType := state.CheckString(-2)
 	body := state.CheckString(-1)
-	resp, err := http.Post(url, contentType, bytes.NewReader([]byte(body)))
+	resp, err := doRequest(state, http.MethodPost, url, contentType, bytes.NewReader([]byte(body)))
 	if err != nil {
 		state.Push(lua.LNil)
 		state.Push(lua.LString(err.Error()))
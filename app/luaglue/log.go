@@ -0,0 +1,53 @@
+package luaglue
+
+import (
+	"strings"
+
+	lua "github.com/yuin/gopher-lua"
+	"github.com/zhangpanyi/basebot/logger"
+)
+
+// LogLoader 加载模块
+func LogLoader(state *lua.LState) int {
+	mod := state.SetFuncs(state.NewTable(), map[string]lua.LGFunction{
+		"debug": logDebug,
+		"info":  logInfo,
+		"warn":  logWarn,
+		"error": logError,
+	})
+	state.Push(mod)
+	return 1
+}
+
+// 拼接日志参数
+func logMessage(state *lua.LState) string {
+	args := make([]string, 0, state.GetTop())
+	for i := 1; i <= state.GetTop(); i++ {
+		args = append(args, state.Get(i).String())
+	}
+	return strings.Join(args, " ")
+}
+
+// 调试日志
+func logDebug(state *lua.LState) int {
+	logger.Debugf("Script: %s", logMessage(state))
+	return 0
+}
+
+// 信息日志
+func logInfo(state *lua.LState) int {
+	logger.Infof("Script: %s", logMessage(state))
+	return 0
+}
+
+// 警告日志
+func logWarn(state *lua.LState) int {
+	logger.Warnf("Script: %s", logMessage(state))
+	return 0
+}
+
+// 错误日志
+func logError(state *lua.LState) int {
+	logger.Errorf("Script: %s", logMessage(state))
+	return 0
+}
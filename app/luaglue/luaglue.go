@@ -1,29 +1,241 @@
 package luaglue
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/yuin/gopher-lua"
+	"github.com/zhangpanyi/basebot/logger"
 )
 
-// LuaGlue Lua胶水
+// ScriptDir 脚本目录
+const ScriptDir = "scripts"
+
+// 脚本入口
+const scriptPath = ScriptDir + "/main.lua"
+
+var (
+	// ErrBusy 没有空闲虚拟机
+	ErrBusy = errors.New("script engine busy")
+	// ErrClosed 脚本引擎已关闭
+	ErrClosed = errors.New("script engine closed")
+)
+
+// Options 脚本引擎选项
+type Options struct {
+	PoolSize        int           // 虚拟机数量
+	Timeout         time.Duration // 调用超时
+	CallStackSize   int           // 调用栈大小
+	RegistryMaxSize int           // 数据栈上限
+}
+
+// 默认选项
+var defaultOptions = Options{
+	PoolSize:        4,
+	Timeout:         10 * time.Second,
+	CallStackSize:   256,
+	RegistryMaxSize: 256 * 1024,
+}
+
+// LuaGlue Lua胶水, 维护多个独立加载脚本的虚拟机, 每次调用占用一个空闲虚拟机
 type LuaGlue struct {
-	closed bool
-	state  *lua.LState
+	options  Options
+	states   chan *lua.LState
+	closed   chan struct{}
+	stopped  chan struct{}
+	once     sync.Once
+	stopOnce sync.Once
+	inflight sync.RWMutex
 }
 
 // NewLuaGlue 创建实例
-func NewLuaGlue() (*LuaGlue, error) {
-	state := lua.NewState()
+func NewLuaGlue(options Options) (*LuaGlue, error) {
+	if options.PoolSize <= 0 {
+		options.PoolSize = defaultOptions.PoolSize
+	}
+	if options.Timeout <= 0 {
+		options.Timeout = defaultOptions.Timeout
+	}
+	if options.CallStackSize <= 0 {
+		options.CallStackSize = defaultOptions.CallStackSize
+	}
+	if options.RegistryMaxSize <= 0 {
+		options.RegistryMaxSize = defaultOptions.RegistryMaxSize
+	}
+
+	glue := LuaGlue{
+		options: options,
+		states:  make(chan *lua.LState, options.PoolSize),
+		closed:  make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	for i := 0; i < options.PoolSize; i++ {
+		state, err := glue.newState()
+		if err != nil {
+			glue.Close()
+			return nil, err
+		}
+		glue.states <- state
+	}
+	go glue.eventLoop()
+	return &glue, nil
+}
+
+// 创建虚拟机, 不加载 os、io 和 debug 库
+func (glue *LuaGlue) newState() (*lua.LState, error) {
+	state := lua.NewState(lua.Options{
+		SkipOpenLibs:        true,
+		CallStackSize:       glue.options.CallStackSize,
+		RegistryMaxSize:     glue.options.RegistryMaxSize,
+		MinimizeStackMemory: true,
+	})
+	libs := []struct {
+		name string
+		fn   lua.LGFunction
+	}{
+		{lua.LoadLibName, lua.OpenPackage},
+		{lua.BaseLibName, lua.OpenBase},
+		{lua.TabLibName, lua.OpenTable},
+		{lua.StringLibName, lua.OpenString},
+		{lua.MathLibName, lua.OpenMath},
+		{lua.CoroutineLibName, lua.OpenCoroutine},
+	}
+	for _, lib := range libs {
+		state.Push(state.NewFunction(lib.fn))
+		state.Push(lua.LString(lib.name))
+		state.Call(1, 0)
+	}
+
+	// 禁止读取脚本目录以外的文件
+	state.SetGlobal("dofile", lua.LNil)
+	state.SetGlobal("loadfile", lua.LNil)
+	if pkg, ok := state.GetGlobal(lua.LoadLibName).(*lua.LTable); ok {
+		state.SetField(pkg, "path", lua.LString("scripts/?.lua"))
+		state.SetField(pkg, "cpath", lua.LString(""))
+	}
+
 	state.PreloadModule("http", HttpLoader)
 	state.PreloadModule("json", JsonLoader)
-	if err := state.DoFile("scripts/main.lua"); err != nil {
+	state.PreloadModule("crypto", CryptoLoader)
+	state.PreloadModule("log", LogLoader)
+	state.PreloadModule("time", TimeLoader)
+	state.PreloadModule("store", StoreLoader)
+	state.PreloadModule("bot", BotLoader)
+
+	ctx, cancel := context.WithTimeout(context.Background(), glue.options.Timeout)
+	defer cancel()
+	state.SetContext(ctx)
+	err := state.DoFile(scriptPath)
+	if err == nil {
+		err = selfTest(state)
+	}
+	state.RemoveContext()
+	if err != nil {
+		state.Close()
 		return nil, err
 	}
-	glue := LuaGlue{state: state}
-	go glue.eventLoop()
-	return &glue, nil
+	return state, nil
+}
+
+// 执行脚本自检, on_init 返回 false 时视为失败
+func selfTest(state *lua.LState) error {
+	fn := state.GetGlobal("on_init")
+	if fn == lua.LNil {
+		return nil
+	}
+
+	err := state.CallByParam(lua.P{
+		Fn:      fn,
+		NRet:    2,
+		Protect: true,
+	})
+	if err != nil {
+		return err
+	}
+	defer state.Pop(2)
+
+	okRet, reasonRet := state.Get(-2), state.Get(-1)
+	if okRet != lua.LNil && !lua.LVAsBool(okRet) {
+		return fmt.Errorf("on_init failed, %s", lua.LVAsString(reasonRet))
+	}
+	return nil
+}
+
+// 占用虚拟机执行调用, 超时的虚拟机会被替换
+func (glue *LuaGlue) call(fn func(state *lua.LState) error) error {
+	glue.inflight.RLock()
+	defer glue.inflight.RUnlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), glue.options.Timeout)
+	defer cancel()
+
+	var state *lua.LState
+	select {
+	case state = <-glue.states:
+	case <-ctx.Done():
+		return ErrBusy
+	case <-glue.closed:
+		return ErrClosed
+	}
+
+	state.SetContext(ctx)
+	err := fn(state)
+	state.RemoveContext()
+	state.SetTop(0)
+
+	// 调用完成时即使已到期也视为成功, 只有被中断的调用才是超时
+	if err != nil && ctx.Err() != nil {
+		glue.replace(state)
+		return ctx.Err()
+	}
+	glue.release(state)
+	return err
+}
+
+// 替换被中断的虚拟机, 创建失败时在后台重试, 保持虚拟机数量不变
+func (glue *LuaGlue) replace(state *lua.LState) {
+	state.Close()
+	state, err := glue.newState()
+	if err == nil {
+		glue.release(state)
+		return
+	}
+	logger.Errorf("Failed to recreate lua state, %v", err)
+	go glue.recreate()
+}
+
+// 定期重试创建虚拟机, 直到成功或者引擎关闭
+func (glue *LuaGlue) recreate() {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			state, err := glue.newState()
+			if err != nil {
+				logger.Warnf("Failed to recreate lua state, %v", err)
+				continue
+			}
+			glue.release(state)
+			return
+		case <-glue.closed:
+			return
+		}
+	}
+}
+
+// 归还虚拟机
+func (glue *LuaGlue) release(state *lua.LState) {
+	select {
+	case <-glue.closed:
+		state.Close()
+	default:
+		glue.states <- state
+	}
 }
 
 // 事件循环
@@ -38,135 +250,265 @@ func (glue *LuaGlue) eventLoop() {
 			glue.OnTick(now.Sub(lasttime).Seconds())
 			lasttime = now
 			timer.Reset(duration)
-		}
-
-		if glue.closed {
-			break
+		case <-glue.stopped:
+			timer.Stop()
+			return
 		}
 	}
 }
 
+// 停止时钟事件
+func (glue *LuaGlue) stopTick() {
+	glue.stopOnce.Do(func() {
+		close(glue.stopped)
+	})
+}
+
+// Shutdown 停止时钟事件, 等待执行中的调用完成后释放资源
+func (glue *LuaGlue) Shutdown() {
+	glue.stopTick()
+	glue.inflight.Lock()
+	defer glue.inflight.Unlock()
+	glue.Close()
+}
+
 // Close 释放资源
 func (glue *LuaGlue) Close() {
-	glue.state.Close()
-	glue.closed = true
+	glue.stopTick()
+	glue.once.Do(func() {
+		close(glue.closed)
+		for {
+			select {
+			case state := <-glue.states:
+				state.Close()
+			default:
+				return
+			}
+		}
+	})
 }
 
-// OnTick 时钟事件
+// OnTick 时钟事件, 每次只在一个虚拟机中执行
 func (glue *LuaGlue) OnTick(delaytime float64) {
-	fn := glue.state.GetGlobal("on_tick")
-	if fn == nil {
-		return
-	}
+	_ = glue.call(func(state *lua.LState) error {
+		fn := state.GetGlobal("on_tick")
+		if fn == lua.LNil {
+			return nil
+		}
 
-	err := glue.state.CallByParam(lua.P{
-		Fn:      fn,
-		NRet:    0,
-		Protect: true,
-	}, lua.LNumber(delaytime))
-	if err != nil {
-		return
-	}
+		return state.CallByParam(lua.P{
+			Fn:      fn,
+			NRet:    0,
+			Protect: true,
+		}, lua.LNumber(delaytime))
+	})
 }
 
 // ValidAddress 地址是否有效
-func (glue *LuaGlue) ValidAddress(address string) bool {
-	fn := glue.state.GetGlobal("valid_address")
-	if fn == nil {
-		return false
-	}
-
-	err := glue.state.CallByParam(lua.P{
-		Fn:      fn,
-		NRet:    1,
-		Protect: true,
-	}, lua.LString(address))
-	if err != nil {
-		return false
-	}
+func (glue *LuaGlue) ValidAddress(address, symbol string) bool {
+	valid := false
+	_ = glue.call(func(state *lua.LState) error {
+		fn := state.GetGlobal("valid_address")
+		if fn == lua.LNil {
+			return nil
+		}
 
-	ret := glue.state.Get(-1)
-	defer glue.state.Pop(1)
-	if ret.Type() != lua.LTBool {
-		return false
-	}
+		err := state.CallByParam(lua.P{
+			Fn:      fn,
+			NRet:    1,
+			Protect: true,
+		}, lua.LString(address), lua.LString(symbol))
+		if err != nil {
+			return err
+		}
 
-	val := ret.(lua.LBool)
-	return bool(val)
+		ret := state.Get(-1)
+		if ret.Type() == lua.LTBool {
+			valid = bool(ret.(lua.LBool))
+		}
+		return nil
+	})
+	return valid
 }
 
 // DepositAddress 获取充值地址
-func (glue *LuaGlue) DepositAddress(userID int64) (string, string) {
-	fn := glue.state.GetGlobal("deposit_address")
-	if fn == nil {
-		return "", ""
-	}
+func (glue *LuaGlue) DepositAddress(userID int64, symbol string) (string, string) {
+	var address, memo string
+	_ = glue.call(func(state *lua.LState) error {
+		fn := state.GetGlobal("deposit_address")
+		if fn == lua.LNil {
+			return nil
+		}
 
-	err := glue.state.CallByParam(lua.P{
-		Fn:      fn,
-		NRet:    2,
-		Protect: true,
-	}, lua.LString(strconv.FormatInt(userID, 10)))
-	if err != nil {
-		return "", ""
-	}
+		err := state.CallByParam(lua.P{
+			Fn:      fn,
+			NRet:    2,
+			Protect: true,
+		}, lua.LString(strconv.FormatInt(userID, 10)), lua.LString(symbol))
+		if err != nil {
+			return err
+		}
 
-	address := ""
-	addrRet := glue.state.Get(-2)
-	if addrRet == nil || addrRet.Type() != lua.LTString {
-		return "", ""
-	}
-	address = string(addrRet.(lua.LString))
+		addrRet := state.Get(-2)
+		if addrRet.Type() != lua.LTString {
+			return nil
+		}
+		address = string(addrRet.(lua.LString))
 
-	var memo string
-	memoRet := glue.state.Get(-1)
-	if memoRet != nil && memoRet.Type() == lua.LTString {
-		memo = string(memoRet.(lua.LString))
-	}
+		memoRet := state.Get(-1)
+		if memoRet.Type() == lua.LTString {
+			memo = string(memoRet.(lua.LString))
+		}
+		return nil
+	})
 	return address, memo
 }
 
-// OnWithdraw 接收提现请求
-func (glue *LuaGlue) OnWithdraw(to, symbol, amount string, id string) {
-	fn := glue.state.GetGlobal("on_withdraw")
-	if fn == nil {
-		return
-	}
+// OnWithdraw 接收提现请求, 脚本需要在本次调用中设置结果
+func (glue *LuaGlue) OnWithdraw(to, symbol, amount string, id string) error {
+	return glue.call(func(state *lua.LState) error {
+		fn := state.GetGlobal("on_withdraw")
+		if fn == lua.LNil {
+			return errors.New("function on_withdraw not found")
+		}
 
-	future := newFuture(glue.state, id)
-	err := glue.state.CallByParam(lua.P{
-		Fn:      fn,
-		NRet:    0,
-		Protect: true,
-	}, lua.LString(to), lua.LString(symbol), lua.LString(amount), future)
-	if err != nil {
-		return
-	}
+		future := newFuture(state, id)
+		return state.CallByParam(lua.P{
+			Fn:      fn,
+			NRet:    0,
+			Protect: true,
+		}, lua.LString(to), lua.LString(symbol), lua.LString(amount), future)
+	})
 }
 
 // ValidTransaction 交易是否有效
 func (glue *LuaGlue) ValidTransaction(txid, from, to, symbol, amount, memo string) bool {
-	fn := glue.state.GetGlobal("valid_transaction")
-	if fn == nil {
-		return false
+	valid := false
+	_ = glue.call(func(state *lua.LState) error {
+		var err error
+		valid, err = validTransaction(state, txid, from, to, symbol, amount, memo)
+		return err
+	})
+	return valid
+}
+
+// 在当前状态中验证交易
+func validTransaction(state *lua.LState, txid, from, to, symbol, amount, memo string) (bool, error) {
+	fn := state.GetGlobal("valid_transaction")
+	if fn == lua.LNil {
+		return false, nil
 	}
 
-	err := glue.state.CallByParam(lua.P{
+	err := state.CallByParam(lua.P{
 		Fn:      fn,
 		NRet:    1,
 		Protect: true,
 	}, lua.LString(txid), lua.LString(from), lua.LString(to), lua.LString(symbol),
 		lua.LString(amount), lua.LString(memo))
 	if err != nil {
-		return false
+		return false, err
+	}
+
+	ret := state.Get(-1)
+	state.Pop(1)
+	if ret.Type() == lua.LTBool {
+		return bool(ret.(lua.LBool)), nil
+	}
+	return false, nil
+}
+
+// Distribute 拆分红包金额, 金额以最小单位字符串表示
+func (glue *LuaGlue) Distribute(amount string, number int) ([]string, error) {
+	var values []string
+	err := glue.call(func(state *lua.LState) error {
+		fn := state.GetGlobal("distribute")
+		if fn == lua.LNil {
+			return errors.New("function distribute not found")
+		}
+
+		err := state.CallByParam(lua.P{
+			Fn:      fn,
+			NRet:    1,
+			Protect: true,
+		}, lua.LString(amount), lua.LNumber(number))
+		if err != nil {
+			return err
+		}
+
+		table, ok := state.Get(-1).(*lua.LTable)
+		if !ok {
+			return errors.New("distribute must return a table")
+		}
+
+		values = make([]string, 0, table.Len())
+		for i := 1; i <= table.Len(); i++ {
+			value := table.RawGetInt(i)
+			if value.Type() != lua.LTString && value.Type() != lua.LTNumber {
+				return errors.New("distribute must return a list of amounts")
+			}
+			values = append(values, value.String())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	return values, nil
+}
 
-	ret := glue.state.Get(-1)
-	defer glue.state.Pop(1)
-	if ret.Type() != lua.LTBool {
-		return false
+// 调用事件钩子, 返回值留在栈上由 after 读取
+func (glue *LuaGlue) callHook(name string, nret int, event map[string]interface{},
+	after func(state *lua.LState)) error {
+
+	return glue.call(func(state *lua.LState) error {
+		fn := state.GetGlobal(name)
+		if fn == lua.LNil {
+			return nil
+		}
+
+		err := state.CallByParam(lua.P{
+			Fn:      fn,
+			NRet:    nret,
+			Protect: true,
+		}, parseObject(state, event))
+		if err != nil {
+			return err
+		}
+		if after != nil {
+			after(state)
+		}
+		return nil
+	})
+}
+
+// OnLuckyMoneyCreated 红包创建事件
+func (glue *LuaGlue) OnLuckyMoneyCreated(event map[string]interface{}) error {
+	return glue.callHook("on_lucky_money_created", 0, event, nil)
+}
+
+// OnLuckyMoneyReceived 红包领取事件, 返回是否允许领取和额外奖励金额, 脚本出错或超时时拒绝领取
+func (glue *LuaGlue) OnLuckyMoneyReceived(event map[string]interface{}) (bool, string, error) {
+	allow, bonus := true, ""
+	err := glue.callHook("on_lucky_money_received", 2, event, func(state *lua.LState) {
+		allowRet := state.Get(-2)
+		bonusRet := state.Get(-1)
+		allow = allowRet == lua.LNil || lua.LVAsBool(allowRet)
+		if bonusRet.Type() == lua.LTString || bonusRet.Type() == lua.LTNumber {
+			bonus = bonusRet.String()
+		}
+	})
+	if err != nil {
+		return false, "", err
 	}
+	return allow, bonus, nil
+}
+
+// OnLuckyMoneyExpired 红包过期事件
+func (glue *LuaGlue) OnLuckyMoneyExpired(event map[string]interface{}) error {
+	return glue.callHook("on_lucky_money_expired", 0, event, nil)
+}
 
-	val := ret.(lua.LBool)
-	return bool(val)
+// OnDeposit 充值到账事件
+func (glue *LuaGlue) OnDeposit(event map[string]interface{}) error {
+	return glue.callHook("on_deposit", 0, event, nil)
 }
@@ -0,0 +1,284 @@
+package luaglue
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	lua "github.com/yuin/gopher-lua"
+	"luckybot/app/storage"
+)
+
+// 创建测试用脚本引擎, 脚本目录和数据库均位于临时目录
+func newTestGlue(t *testing.T) *LuaGlue {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, ScriptDir), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, scriptPath), []byte("function on_init() return true end\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	if err = storage.Connect(filepath.Join(dir, "test.db")); err != nil {
+		t.Fatal(err)
+	}
+
+	glue, err := NewLuaGlue(Options{PoolSize: 1, Timeout: time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		glue.Shutdown()
+		_ = storage.Close()
+		_ = os.Chdir(wd)
+	})
+	return glue
+}
+
+// 在虚拟机中执行代码片段, 返回nret个返回值
+func runSnippet(t *testing.T, glue *LuaGlue, code string, nret int) []lua.LValue {
+	t.Helper()
+	values := make([]lua.LValue, 0, nret)
+	err := glue.call(func(state *lua.LState) error {
+		fn, err := state.LoadString(code)
+		if err != nil {
+			return err
+		}
+		state.Push(fn)
+		if err = state.PCall(0, nret, nil); err != nil {
+			return err
+		}
+		for i := nret; i > 0; i-- {
+			values = append(values, state.Get(-i))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return values
+}
+
+// 检查字符串返回值
+func expectStrings(t *testing.T, values []lua.LValue, expected ...string) {
+	t.Helper()
+	for i, value := range values {
+		if value.Type() != lua.LTString || value.String() != expected[i] {
+			t.Fatalf("return value %d = %v, want %q", i+1, value, expected[i])
+		}
+	}
+}
+
+func TestCryptoModule(t *testing.T) {
+	glue := newTestGlue(t)
+
+	// 摘要
+	values := runSnippet(t, glue, `
+		local crypto = require("crypto")
+		return crypto.sha256("abc"), crypto.hex_encode(crypto.sha256("abc", true))
+	`, 2)
+	sha256abc := "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"
+	expectStrings(t, values, sha256abc, sha256abc)
+
+	// HMAC
+	mac := hmac.New(sha512.New, []byte("key"))
+	mac.Write([]byte("data"))
+	values = runSnippet(t, glue, `
+		local crypto = require("crypto")
+		return crypto.hmac_sha256("key", "The quick brown fox jumps over the lazy dog"),
+			crypto.hmac_sha512("key", "data")
+	`, 2)
+	expectStrings(t, values,
+		"f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8",
+		hex.EncodeToString(mac.Sum(nil)))
+
+	// 编码
+	values = runSnippet(t, glue, `
+		local crypto = require("crypto")
+		local data, err = crypto.hex_decode("68656c6c6f")
+		assert(err == nil)
+		local decoded, err = crypto.base58_decode(crypto.base58_encode("\0\0hello"))
+		assert(err == nil)
+		return data, crypto.base58_encode("hello world"), decoded == "\0\0hello" and "ok" or "mismatch"
+	`, 3)
+	expectStrings(t, values, "hello", "StV1DL6CwTryKyV", "ok")
+
+	// 无效输入
+	values = runSnippet(t, glue, `
+		local crypto = require("crypto")
+		local _, hexErr = crypto.hex_decode("zz")
+		local _, b58Err = crypto.base58_decode("0OIl")
+		return hexErr ~= nil, b58Err ~= nil
+	`, 2)
+	if values[0] != lua.LTrue || values[1] != lua.LTrue {
+		t.Fatalf("expected decode errors, got %v", values)
+	}
+}
+
+func TestTimeModule(t *testing.T) {
+	glue := newTestGlue(t)
+
+	before := time.Now().Unix()
+	values := runSnippet(t, glue, `
+		local time = require("time")
+		return time.now(), time.millis()
+	`, 2)
+	after := time.Now().Unix()
+	now := int64(lua.LVAsNumber(values[0]))
+	millis := int64(lua.LVAsNumber(values[1]))
+	if now < before || now > after {
+		t.Fatalf("time.now() = %d, want between %d and %d", now, before, after)
+	}
+	if millis/1000 < before || millis/1000 > after {
+		t.Fatalf("time.millis() = %d, want between %d and %d", millis, before*1000, after*1000)
+	}
+
+	values = runSnippet(t, glue, `
+		local time = require("time")
+		local ts, err = time.parse("2020-01-01 08:00:00")
+		assert(err == nil, err)
+		local day, err = time.parse("2020-01-02", "2006-01-02")
+		assert(err == nil, err)
+		local _, bad = time.parse("not a time")
+		return ts, day, time.format(ts), time.format(ts, "2006/01/02"), bad ~= nil
+	`, 5)
+	if int64(lua.LVAsNumber(values[0])) != 1577836800 {
+		t.Fatalf("time.parse() = %v, want 1577836800", values[0])
+	}
+	if int64(lua.LVAsNumber(values[1])) != 1577894400 {
+		t.Fatalf("time.parse() with layout = %v, want 1577894400", values[1])
+	}
+	expectStrings(t, values[2:4], "2020-01-01 08:00:00", "2020/01/01")
+	if values[4] != lua.LTrue {
+		t.Fatal("expected error for invalid time")
+	}
+}
+
+func TestStoreModule(t *testing.T) {
+	glue := newTestGlue(t)
+
+	values := runSnippet(t, glue, `
+		local store = require("store")
+		assert(store.set("ns", "a", "hello") == nil)
+		assert(store.set("ns", "b", 42) == nil)
+		assert(store.set("ns", "c", true) == nil)
+		local a = store.get("ns", "a")
+		local b = store.get("ns", "b")
+		local keys = store.keys("ns")
+		return a, b, table.concat(keys, ",")
+	`, 3)
+	expectStrings(t, values, "hello", "42", "a,b,c")
+
+	values = runSnippet(t, glue, `
+		local store = require("store")
+		assert(store.delete("ns", "a") == nil)
+		local a, err = store.get("ns", "a")
+		local missing = store.get("other", "a")
+		local keys = store.keys("ns")
+		return a, err, missing, #keys
+	`, 4)
+	if values[0] != lua.LNil || values[1] != lua.LNil || values[2] != lua.LNil {
+		t.Fatalf("expected deleted and missing keys to be nil, got %v", values)
+	}
+	if lua.LVAsNumber(values[3]) != 2 {
+		t.Fatalf("expected 2 keys after delete, got %v", values[3])
+	}
+
+	values = runSnippet(t, glue, `
+		local store = require("store")
+		local _, err = store.get("", "a")
+		return err
+	`, 1)
+	if values[0].Type() != lua.LTString {
+		t.Fatalf("expected error for empty namespace, got %v", values[0])
+	}
+}
+
+func TestLogModule(t *testing.T) {
+	glue := newTestGlue(t)
+
+	values := runSnippet(t, glue, `
+		local log = require("log")
+		log.debug("debug", 1)
+		log.info("info", true, nil)
+		log.warn("warn", {})
+		log.error("error")
+		return "done"
+	`, 1)
+	expectStrings(t, values, "done")
+
+	// 日志参数拼接
+	var message string
+	err := glue.call(func(state *lua.LState) error {
+		state.Push(lua.LString("amount"))
+		state.Push(lua.LNumber(1.5))
+		state.Push(lua.LTrue)
+		state.Push(lua.LNil)
+		message = logMessage(state)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if message != "amount 1.5 true nil" {
+		t.Fatalf("log message = %q", message)
+	}
+}
+
+func TestCallTimeout(t *testing.T) {
+	newTestGlue(t)
+	glue, err := NewLuaGlue(Options{PoolSize: 1, Timeout: 100 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer glue.Shutdown()
+
+	// 已完成的调用不视为超时
+	err = glue.call(func(state *lua.LState) error {
+		time.Sleep(150 * time.Millisecond)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("completed call returned %v", err)
+	}
+
+	// 中断的调用返回超时, 脚本损坏时虚拟机在后台重建
+	if err = os.WriteFile(scriptPath, []byte("function on_init("), 0644); err != nil {
+		t.Fatal(err)
+	}
+	err = glue.call(func(state *lua.LState) error {
+		return state.DoString("while true do end")
+	})
+	if err != context.DeadlineExceeded {
+		t.Fatalf("expected timeout, got %v", err)
+	}
+	if err = glue.call(func(*lua.LState) error { return nil }); err != ErrBusy {
+		t.Fatalf("expected ErrBusy while recreating, got %v", err)
+	}
+	if err = os.WriteFile(scriptPath, []byte("function on_init() return true end\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if err = glue.call(func(*lua.LState) error { return nil }); err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("lua state was not recreated, %v", err)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
@@ -0,0 +1,84 @@
+package luaglue
+
+import (
+	lua "github.com/yuin/gopher-lua"
+	"luckybot/app/storage/models"
+)
+
+// StoreLoader 加载模块
+func StoreLoader(state *lua.LState) int {
+	mod := state.SetFuncs(state.NewTable(), map[string]lua.LGFunction{
+		"get":    storeGet,
+		"set":    storeSet,
+		"delete": storeDelete,
+		"keys":   storeKeys,
+	})
+	state.Push(mod)
+	return 1
+}
+
+// 输出错误
+func pushError(state *lua.LState, err error) {
+	if err != nil {
+		state.Push(lua.LString(err.Error()))
+	} else {
+		state.Push(lua.LNil)
+	}
+}
+
+// 获取数据, 不存在时返回nil
+func storeGet(state *lua.LState) int {
+	namespace := state.CheckString(1)
+	key := state.CheckString(2)
+	model := models.ScriptStoreModel{}
+	value, ok, err := model.Get(namespace, key)
+	if err != nil || !ok {
+		state.Push(lua.LNil)
+	} else {
+		state.Push(lua.LString(value))
+	}
+	pushError(state, err)
+	return 2
+}
+
+// 设置数据, 数值会被转换为字符串
+func storeSet(state *lua.LState) int {
+	namespace := state.CheckString(1)
+	key := state.CheckString(2)
+	value := state.CheckAny(3)
+	if value.Type() != lua.LTString && value.Type() != lua.LTNumber && value.Type() != lua.LTBool {
+		state.ArgError(3, "string, number or boolean expected")
+		return 0
+	}
+	model := models.ScriptStoreModel{}
+	pushError(state, model.Set(namespace, key, value.String()))
+	return 1
+}
+
+// 删除数据
+func storeDelete(state *lua.LState) int {
+	namespace := state.CheckString(1)
+	key := state.CheckString(2)
+	model := models.ScriptStoreModel{}
+	pushError(state, model.Delete(namespace, key))
+	return 1
+}
+
+// 获取所有键
+func storeKeys(state *lua.LState) int {
+	namespace := state.CheckString(1)
+	model := models.ScriptStoreModel{}
+	keys, err := model.Keys(namespace)
+	if err != nil {
+		state.Push(lua.LNil)
+		pushError(state, err)
+		return 2
+	}
+	table := state.NewTable()
+	for _, key := range keys {
+		table.Append(lua.LString(key))
+	}
+	state.Push(table)
+	state.Push(lua.LNil)
+	return 2
+}
@@ -0,0 +1,55 @@
+package luaglue
+
+import (
+	"time"
+
+	lua "github.com/yuin/gopher-lua"
+	"luckybot/app/location"
+)
+
+// TimeLoader 加载模块
+func TimeLoader(state *lua.LState) int {
+	mod := state.SetFuncs(state.NewTable(), map[string]lua.LGFunction{
+		"now":    timeNow,
+		"millis": timeMillis,
+		"format": timeFormat,
+		"parse":  timeParse,
+	})
+	state.Push(mod)
+	return 1
+}
+
+// 当前时间戳(秒)
+func timeNow(state *lua.LState) int {
+	state.Push(lua.LNumber(time.Now().Unix()))
+	return 1
+}
+
+// 当前时间戳(毫秒)
+func timeMillis(state *lua.LState) int {
+	state.Push(lua.LNumber(time.Now().UnixNano() / int64(time.Millisecond)))
+	return 1
+}
+
+// 格式化时间, 默认格式为 2006-01-02 15:04:05
+func timeFormat(state *lua.LState) int {
+	timestamp := state.CheckInt64(1)
+	layout := state.OptString(2, location.RFC3339LITE)
+	state.Push(lua.LString(location.FormatLayout(timestamp, layout)))
+	return 1
+}
+
+// 解析时间, 默认格式为 2006-01-02 15:04:05
+func timeParse(state *lua.LState) int {
+	value := state.CheckString(1)
+	layout := state.OptString(2, location.RFC3339LITE)
+	t, err := location.ParseInLocation(layout, value)
+	if err != nil {
+		state.Push(lua.LNil)
+		state.Push(lua.LString(err.Error()))
+		return 2
+	}
+	state.Push(lua.LNumber(t.Unix()))
+	state.Push(lua.LNil)
+	return 2
+}
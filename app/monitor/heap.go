@@ -1,19 +1,28 @@
 package monitor
 
-// 过期信息
-type expire struct {
-	ID        uint64 // 红包ID
-	Timestamp int64  // 时间戳
+// 事件类型
+type eventType int
+
+const (
+	eventExpire   eventType = iota // 红包过期
+	eventActivate                  // 红包开抢
+)
+
+// 定时事件
+type event struct {
+	ID        uint64    // 红包ID
+	Timestamp int64     // 触发时间
+	Type      eventType // 事件类型
 }
 
 // 堆结构
-type heapExpire []expire
+type heapEvent []event
 
 // Len 堆大小
-func (h heapExpire) Len() int { return len(h) }
+func (h heapEvent) Len() int { return len(h) }
 
 // Less 比较大小
-func (h heapExpire) Less(i, j int) bool {
+func (h heapEvent) Less(i, j int) bool {
 	if h[i].Timestamp == h[j].Timestamp {
 		return h[i].ID < h[j].ID
 	}
@@ -21,15 +30,15 @@ func (h heapExpire) Less(i, j int) bool {
 }
 
 // Swap 交换元素
-func (h heapExpire) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h heapEvent) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
 
 // Push 添加元素
-func (h *heapExpire) Push(x interface{}) {
-	*h = append(*h, x.(expire))
+func (h *heapEvent) Push(x interface{}) {
+	*h = append(*h, x.(event))
 }
 
 // Pop 删除元素
-func (h *heapExpire) Pop() interface{} {
+func (h *heapEvent) Pop() interface{} {
 	if h.Len() == 0 {
 		return nil
 	}
@@ -41,7 +50,7 @@ func (h *heapExpire) Pop() interface{} {
 }
 
 // Front 首个元素
-func (h *heapExpire) Front() *expire {
+func (h *heapEvent) Front() *event {
 	if h.Len() == 0 {
 		return nil
 	}
@@ -3,7 +3,6 @@ package monitor
 import (
 	"container/heap"
 	"errors"
-	"math/big"
 	"sync"
 	"time"
 
@@ -14,6 +13,7 @@ import (
 	"luckybot/app/fmath"
 	"luckybot/app/logic/handlers/utils"
 	"luckybot/app/logic/pusher"
+	"luckybot/app/logic/scriptengine"
 	"luckybot/app/storage"
 	"luckybot/app/storage/models"
 )
@@ -21,8 +21,12 @@ import (
 var once sync.Once
 var monitor *Monitor
 
+// ActivateHandler 红包开抢处理器, 用于更新开抢前发出的内联消息
+type ActivateHandler func(bot *methods.BotExt, luckyMoney *models.LuckyMoney, received uint32,
+	inlineMessageIDs []string)
+
 // StartChecking 开始检查
-func StartChecking(bot *methods.BotExt, pool *updater.Pool) {
+func StartChecking(bot *methods.BotExt, pool *updater.Pool, onActivate ActivateHandler) {
 	once.Do(func() {
 		// 获取过期红包
 		model := models.LuckyMoneyModel{}
@@ -31,23 +35,23 @@ func StartChecking(bot *methods.BotExt, pool *updater.Pool) {
 			logger.Panic(err)
 		}
 
+		// 初始化红包检查器
+		serverCfg := config.GetServe()
+		monitor = &Monitor{
+			h:          make(heapEvent, 0),
+			bot:        bot,
+			pool:       pool,
+			expire:     serverCfg.Expire,
+			onActivate: onActivate,
+		}
+
 		// 遍历未过期列表
-		h := make(heapExpire, 0)
 		err = model.Foreach(id+1, func(data *models.LuckyMoney) {
-			heap.Push(&h, expire{ID: data.ID, Timestamp: data.Timestamp})
+			monitor.pushEvents(data)
 		})
 		if err != nil && !errors.Is(err, storage.ErrNoBucket) {
 			logger.Panic(err)
 		}
-
-		// 初始化红包检查器
-		serverCfg := config.GetServe()
-		monitor = &Monitor{
-			h:      h,
-			bot:    bot,
-			pool:   pool,
-			expire: serverCfg.Expire,
-		}
 		go monitor.loop()
 	})
 }
@@ -58,19 +62,36 @@ func GetBot() *methods.BotExt {
 }
 
 // AddToQueue 添加红包
-func AddToQueue(id uint64, timestamp int64) {
+func AddToQueue(luckyMoney *models.LuckyMoney) {
 	monitor.lock.Lock()
 	defer monitor.lock.Unlock()
-	heap.Push(&monitor.h, expire{ID: id, Timestamp: timestamp})
+	monitor.pushEvents(luckyMoney)
 }
 
 // Monitor 检查员
 type Monitor struct {
-	h      heapExpire
-	bot    *methods.BotExt
-	pool   *updater.Pool
-	lock   sync.RWMutex
-	expire uint32
+	h          heapEvent
+	bot        *methods.BotExt
+	pool       *updater.Pool
+	lock       sync.RWMutex
+	expire     uint32
+	onActivate ActivateHandler
+}
+
+// 添加红包事件
+func (t *Monitor) pushEvents(luckyMoney *models.LuckyMoney) {
+	heap.Push(&t.h, event{
+		ID:        luckyMoney.ID,
+		Timestamp: luckyMoney.ExpireTime(int64(t.expire)),
+		Type:      eventExpire,
+	})
+	if luckyMoney.ActivateAt > 0 {
+		heap.Push(&t.h, event{
+			ID:        luckyMoney.ID,
+			Timestamp: luckyMoney.ActivateAt,
+			Type:      eventActivate,
+		})
+	}
 }
 
 // 事件循环
@@ -79,38 +100,58 @@ func (t *Monitor) loop() {
 	for {
 		select {
 		case <-tickTimer.C:
-			t.handleLuckyMoneyExpire()
+			t.handleEvents()
 			tickTimer.Reset(time.Second)
 		}
 	}
 }
 
-// 处理过期红包
-func (t *Monitor) handleLuckyMoneyExpire() {
+// 处理到期事件
+func (t *Monitor) handleEvents() {
 	var id uint64
 	t.lock.RLock()
 	now := time.Now().UTC().Unix()
 	for t.h.Len() > 0 {
-		data := t.h.Front()
+		data := *t.h.Front()
 		t.lock.RUnlock()
 
-		// 判断是否过期
-		if now-data.Timestamp < int64(t.expire) {
-			return
+		// 判断是否到期
+		if data.Timestamp > now {
+			t.lock.RLock()
+			break
 		}
 
-		// 获取过期信息
+		// 获取事件信息
 		t.lock.Lock()
-		e := heap.Pop(&t.h).(expire)
+		e := heap.Pop(&t.h).(event)
 		t.lock.Unlock()
 
-		id = e.ID
-		logger.Infof("Lucky money expired, %v", e.Timestamp)
-		t.pool.Async(func() {
-			t.asyncHandleLuckyMoneyExpire(e.ID)
-		})
+		switch e.Type {
+		case eventExpire:
+			if e.ID > id {
+				id = e.ID
+			}
+			logger.Infof("Lucky money expired, %v", e.ID)
+			t.pool.Async(func() {
+				t.asyncHandleLuckyMoneyExpire(e.ID)
+			})
+		case eventActivate:
+			logger.Infof("Lucky money activated, %v", e.ID)
+			t.pool.Async(func() {
+				t.asyncHandleLuckyMoneyActivate(e.ID)
+			})
+		}
 		t.lock.RLock()
 	}
+
+	// 跳过未过期红包
+	if id != 0 {
+		for _, e := range t.h {
+			if e.Type == eventExpire && e.ID <= id {
+				id = e.ID - 1
+			}
+		}
+	}
 	t.lock.RUnlock()
 
 	// 更新过期红包
@@ -124,59 +165,76 @@ func (t *Monitor) handleLuckyMoneyExpire() {
 
 // 异步处理过期红包
 func (t *Monitor) asyncHandleLuckyMoneyExpire(id uint64) {
-	// 设置红包过期
+	// 检查过期时间
 	model := models.LuckyMoneyModel{}
 	if model.IsExpired(id) {
 		return
 	}
-	err := model.SetExpired(id)
+	luckyMoney, _, err := model.GetLuckyMoney(id)
 	if err != nil {
-		logger.Infof("Failed to set expired of lucky money, %v", err)
+		logger.Warnf("Failed to set expired of lucky money, not found lucky money, %d, %v", id, err)
+		return
+	}
+	if luckyMoney.ExpireTime(int64(t.expire)) > time.Now().UTC().Unix() {
 		return
 	}
 
-	// 获取红包信息
-	luckyMoney, received, err := model.GetLuckyMoney(id)
+	// 设置红包过期
+	version, err := model.SetExpired(id)
 	if err != nil {
-		logger.Warnf("Failed to set expired of lucky money, not found lucky money, %d, %v", id, err)
+		logger.Infof("Failed to set expired of lucky money, %v", err)
 		return
 	}
 
+	// 通知脚本引擎
+	event := scriptengine.LuckyMoneyEvent(luckyMoney)
+	serveCfg := config.GetServe()
+	event["refund"] = refundAmount(version).Format(serveCfg.Precision(luckyMoney.Asset))
+	if err = scriptengine.Engine().OnLuckyMoneyExpired(event); err != nil {
+		logger.Warnf("Failed to call on_lucky_money_expired, id: %d, %v", id, err)
+	}
+
 	// 是否领完了
-	if received == luckyMoney.Number {
+	if version == nil {
 		return
 	}
+	logger.Infof("Return lucky money asset of expired, id=%d, asset=%s", id, version.Symbol)
+
+	// 推送退还通知
+	pusher.Post(luckyMoney.SenderID, utils.MakeHistoryMessage(luckyMoney.SenderID, version), true, nil)
+}
 
-	// 计算红包余额
-	balance := fmath.Sub(luckyMoney.Amount, luckyMoney.Received)
-	if !luckyMoney.Lucky {
-		amount := fmath.Mul(luckyMoney.Amount, big.NewFloat(float64(luckyMoney.Number)))
-		balance.Sub(amount, luckyMoney.Received)
+// 过期退还金额, 退还版本只记录锁定变化
+func refundAmount(version *models.Version) *fmath.Amount {
+	if version == nil || version.Locked == nil {
+		return fmath.Zero()
 	}
+	return fmath.Abs(version.Locked)
+}
 
-	// 返还红包余额
-	accountModel := models.AccountModel{}
-	account, err := accountModel.UnlockAccount(luckyMoney.SenderID, luckyMoney.Asset, balance)
+// 异步处理红包开抢
+func (t *Monitor) asyncHandleLuckyMoneyActivate(id uint64) {
+	// 检查开抢时间
+	model := models.LuckyMoneyModel{}
+	if model.IsExpired(id) {
+		return
+	}
+	luckyMoney, received, err := model.GetLuckyMoney(id)
 	if err != nil {
-		logger.Errorf("Failed to return lucky money asset of expired, %v", err)
+		logger.Warnf("Failed to activate lucky money, not found lucky money, %d, %v", id, err)
+		return
+	}
+	if luckyMoney.ActivateAt > time.Now().UTC().Unix() {
 		return
 	}
-	logger.Errorf("Return lucky money asset of expired, user=%d, asset=%s, amount=%s",
-		luckyMoney.SenderID, luckyMoney.Asset, balance.String())
-
-	// 插入账户记录
-	zero := big.NewFloat(0)
-	versionModel := models.AccountVersionModel{}
-	version, err := versionModel.InsertVersion(luckyMoney.SenderID, &models.Version{
-		Symbol:          luckyMoney.Asset,
-		Locked:          zero.Sub(zero, balance),
-		Amount:          account.Amount,
-		Reason:          models.ReasonGiveBack,
-		RefLuckyMoneyID: &luckyMoney.ID,
-	})
 
-	// 推送退还通知
-	if err == nil {
-		pusher.Post(luckyMoney.SenderID, utils.MakeHistoryMessage(luckyMoney.SenderID, version), true, nil)
+	// 更新内联消息
+	messages, err := model.PopInlineMessages(id)
+	if err != nil {
+		logger.Warnf("Failed to get inline messages of lucky money, %d, %v", id, err)
+		return
+	}
+	if len(messages) > 0 && t.onActivate != nil {
+		t.onActivate(t.bot, luckyMoney, received, messages)
 	}
 }
@@ -0,0 +1,73 @@
+package monitor
+
+import (
+	"path/filepath"
+	"testing"
+
+	"luckybot/app/fmath"
+	"luckybot/app/storage"
+	"luckybot/app/storage/models"
+)
+
+func TestRefundAmountOfPartlyClaimed(t *testing.T) {
+	if err := storage.Connect(filepath.Join(t.TempDir(), "test.db")); err != nil {
+		t.Fatal(err)
+	}
+	defer storage.Close()
+
+	// 发送者充值
+	const senderID, receiverID = 1001, 1002
+	accountModel := models.AccountModel{}
+	_, _, err := accountModel.Deposit(senderID, "BTC", fmath.NewAmount(1000), &models.Version{
+		Balance: fmath.NewAmount(1000),
+		Reason:  models.ReasonDeposit,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 创建红包并领取一个
+	model := models.LuckyMoneyModel{}
+	values := []*fmath.Amount{fmath.NewAmount(100), fmath.NewAmount(200), fmath.NewAmount(300)}
+	luckyMoney, err := model.NewLuckyMoney(&models.LuckyMoney{
+		SenderID: senderID,
+		Asset:    "BTC",
+		Amount:   fmath.NewAmount(600),
+		Number:   3,
+		Lucky:    true,
+	}, values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, _, _, err := model.ReceiveLuckyMoney(luckyMoney.ID, &models.LuckyMoneyUser{UserID: receiverID}, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 设置过期
+	version, err := model.SetExpired(luckyMoney.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version == nil {
+		t.Fatal("expected refund version")
+	}
+	expected := fmath.Sub(fmath.NewAmount(600), value)
+	if refund := refundAmount(version); refund.Cmp(expected) != 0 {
+		t.Fatalf("refund = %s, want %s", refund, expected)
+	}
+
+	account, err := accountModel.GetAccount(senderID, "BTC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if account.Locked.Sign() != 0 || account.Amount.Cmp(fmath.Sub(fmath.NewAmount(1000), value)) != 0 {
+		t.Fatalf("unexpected sender account, amount=%s, locked=%s", account.Amount, account.Locked)
+	}
+}
+
+func TestRefundAmountOfFullyClaimed(t *testing.T) {
+	if refund := refundAmount(nil); refund.Sign() != 0 {
+		t.Fatalf("refund = %s, want 0", refund)
+	}
+}
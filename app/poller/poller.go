@@ -1,6 +1,8 @@
 package poll
 
 import (
+	"time"
+
 	"github.com/zhangpanyi/basebot/logger"
 	"github.com/zhangpanyi/basebot/telegram/methods"
 	"github.com/zhangpanyi/basebot/telegram/updater"
@@ -33,14 +35,26 @@ func (poller *Poller) StartPoll(token string, handler updater.Handler) (*methods
 
 }
 
+// 轮询失败重试间隔
+const (
+	minBackoff = time.Second
+	maxBackoff = time.Minute
+)
+
 func (poller *Poller) startPoll(bot *methods.BotExt, handler updater.Handler) {
 	var offset uint32
+	backoff := minBackoff
 	for {
 		updates, err := bot.GetUpdates(5, offset)
 		if err != nil {
-			logger.Infof("Failed to get updates, %v", err)
+			logger.Infof("Failed to get updates, retry after %v, %v", backoff, err)
+			time.Sleep(backoff)
+			if backoff *= 2; backoff > maxBackoff {
+				backoff = maxBackoff
+			}
 			continue
 		}
+		backoff = minBackoff
 
 		for i := 0; i < len(updates); i++ {
 			go handler(bot, updates[i])
@@ -0,0 +1,97 @@
+package poll
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/zhangpanyi/basebot/logger"
+	"github.com/zhangpanyi/basebot/telegram/methods"
+	"github.com/zhangpanyi/basebot/telegram/types"
+	"github.com/zhangpanyi/basebot/telegram/updater"
+)
+
+// 密钥请求头
+const secretTokenHeader = "X-Telegram-Bot-Api-Secret-Token"
+
+// WebhookOptions Webhook选项
+type WebhookOptions struct {
+	URL            string // 回调地址
+	SecretToken    string // 验证密钥
+	MaxConnections int32  // 最大连接数
+	Certificate    []byte // 自签名证书
+}
+
+// StartWebhook 开始监听Webhook
+func (poller *Poller) StartWebhook(token string, router *mux.Router, options *WebhookOptions,
+	handler updater.Handler) (*methods.BotExt, error) {
+
+	bot, err := methods.GetMe(poller.apiaccess, token)
+	if err != nil {
+		return nil, err
+	}
+
+	// 注册回调路由
+	u, err := url.Parse(options.URL)
+	if err != nil {
+		return nil, err
+	}
+	path := u.Path
+	if len(path) == 0 {
+		path = "/"
+	}
+	router.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		poller.handleWebhook(bot, options.SecretToken, handler, w, r)
+	}).Methods(http.MethodPost)
+
+	// 设置Webhook地址
+	if err = poller.setWebhook(bot, options); err != nil {
+		return nil, err
+	}
+	return bot, nil
+}
+
+// 设置Webhook
+func (poller *Poller) setWebhook(bot *methods.BotExt, options *WebhookOptions) error {
+	formdata := make([]methods.Field, 0)
+	formdata = append(formdata, methods.Field{Name: "url", Text: options.URL})
+	formdata = append(formdata, methods.Field{Name: "secret_token", Text: options.SecretToken})
+	if options.MaxConnections > 0 {
+		formdata = append(formdata, methods.Field{Name: "max_connections",
+			Text: strconv.FormatInt(int64(options.MaxConnections), 10)})
+	}
+	if len(options.Certificate) > 0 {
+		formdata = append(formdata, methods.Field{Name: "certificate",
+			File: options.Certificate, FileName: "public.pem"})
+	}
+	_, err := bot.Upload("setWebhook", formdata)
+	return err
+}
+
+// 处理Webhook请求
+func (poller *Poller) handleWebhook(bot *methods.BotExt, secretToken string, handler updater.Handler,
+	w http.ResponseWriter, r *http.Request) {
+
+	// 验证密钥
+	defer r.Body.Close()
+	token := r.Header.Get(secretTokenHeader)
+	if subtle.ConstantTimeCompare([]byte(token), []byte(secretToken)) != 1 {
+		logger.Warnf("Invalid webhook secret token, remote: %s", r.RemoteAddr)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	// 解析更新内容
+	var update types.Update
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		logger.Infof("Failed to decode webhook update, %v", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	go handler(bot, &update)
+	w.WriteHeader(http.StatusOK)
+}
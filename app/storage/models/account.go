@@ -3,7 +3,6 @@ package models
 import (
 	"encoding/json"
 	"errors"
-	"math/big"
 	"strconv"
 
 	"github.com/boltdb/bolt"
@@ -13,20 +12,11 @@ import (
 
 // Account 账户数据
 type Account struct {
-	Symbol  string     `json:"symbol"`  // 货币符号
-	Amount  *big.Float `json:"amount"`  // 资产金额
-	Locked  *big.Float `json:"locked"`  // 锁定金额
-	Disable bool       `json:"disable"` // 禁用账户
-}
-
-// Normalization 标准化
-func (account *Account) Normalization() {
-	if account.Amount != nil {
-		account.Amount.SetPrec(fmath.Prec())
-	}
-	if account.Locked != nil {
-		account.Locked.SetPrec(fmath.Prec())
-	}
+	Symbol        string        `json:"symbol"`                   // 货币符号
+	Amount        *fmath.Amount `json:"amount"`                   // 资产金额
+	Locked        *fmath.Amount `json:"locked"`                   // 锁定金额
+	Disable       bool          `json:"disable"`                  // 禁用账户
+	DisableReason string        `json:"disable_reason,omitempty"` // 禁用原因
 }
 
 var (
@@ -42,7 +32,9 @@ var (
 // 		<user_id>: {
 // 			<symbol>: {			// 账户信息
 // 				"amount": 0,	// 资产金额
-// 				"locked": 0		// 锁定金额
+// 				"locked": 0,	// 锁定金额
+// 				"disable": false,	// 是否冻结
+// 				"disable_reason": ""	// 冻结原因
 //			}
 // 		}
 //	}
@@ -67,7 +59,6 @@ func (model *AccountModel) GetAccounts(userID int64) ([]*Account, error) {
 			if err = json.Unmarshal(v, &account); err != nil {
 				return err
 			}
-			account.Normalization()
 			accounts = append(accounts, &account)
 			return nil
 		})
@@ -102,7 +93,6 @@ func (model *AccountModel) GetAccount(userID int64, symbol string) (*Account, er
 		if err = json.Unmarshal(jsb, &account); err != nil {
 			return err
 		}
-		account.Normalization()
 		return nil
 	})
 
@@ -113,244 +103,360 @@ func (model *AccountModel) GetAccount(userID int64, symbol string) (*Account, er
 }
 
 // Deposit 账户存款操作
-func (model *AccountModel) Deposit(userID int64, symbol string, amount *big.Float) (*Account, error) {
-	var account Account
-	key := strconv.FormatInt(userID, 10)
+func (model *AccountModel) Deposit(userID int64, symbol string, amount *fmath.Amount,
+	version *Version) (*Account, *Version, error) {
+
+	var account *Account
 	err := storage.DB.Update(func(tx *bolt.Tx) error {
-		bucket, err := storage.EnsureBucketExists(tx, "accounts", key)
-		if err != nil {
-			return err
-		}
+		var err error
+		account, err = model.depositAccount(tx, userID, symbol, amount, version)
+		return err
+	})
 
-		jsb := bucket.Get([]byte(symbol))
-		if jsb == nil {
-			account.Symbol = symbol
-			account.Amount = amount
-			account.Locked = big.NewFloat(0)
-		} else {
-			if err = json.Unmarshal(jsb, &account); err != nil {
-				return err
-			}
-			account.Normalization()
-			account.Amount.Add(account.Amount, amount)
-		}
+	if err != nil {
+		return nil, nil, err
+	}
+	return account, version, nil
+}
 
-		jsb, err = json.Marshal(&account)
-		if err != nil {
-			return err
-		}
+// Withdraw 账户取款操作
+func (model *AccountModel) Withdraw(userID int64, symbol string, amount *fmath.Amount,
+	version *Version) (*Account, *Version, error) {
 
-		if err = bucket.Put([]byte(symbol), jsb); err != nil {
-			return err
-		}
-		return nil
+	var account *Account
+	err := storage.DB.Update(func(tx *bolt.Tx) error {
+		var err error
+		account, err = model.withdrawAccount(tx, userID, symbol, amount, version)
+		return err
 	})
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return &account, nil
+	return account, version, nil
 }
 
-// Withdraw 账户取款操作
-func (model *AccountModel) Withdraw(userID int64, symbol string, amount *big.Float) (*Account, error) {
-	var account Account
-	key := strconv.FormatInt(userID, 10)
+// LockAccount 锁定账户资金
+func (model *AccountModel) LockAccount(userID int64, symbol string, amount *fmath.Amount,
+	version *Version) (*Account, *Version, error) {
+
+	var account *Account
 	err := storage.DB.Update(func(tx *bolt.Tx) error {
-		bucket, err := storage.EnsureBucketExists(tx, "accounts", key)
-		if err != nil {
-			return err
-		}
+		var err error
+		account, err = model.lockAccount(tx, userID, symbol, amount, version)
+		return err
+	})
 
-		jsb := bucket.Get([]byte(symbol))
-		if jsb == nil {
-			return ErrNoSuchTypeAccount
-		}
+	if err != nil {
+		return nil, nil, err
+	}
+	return account, version, nil
+}
 
-		if err = json.Unmarshal(jsb, &account); err != nil {
-			return err
-		}
-		account.Normalization()
+// UnlockAccount 解锁账户资金
+func (model *AccountModel) UnlockAccount(userID int64, symbol string, amount *fmath.Amount,
+	version *Version) (*Account, *Version, error) {
 
-		if account.Locked.Cmp(amount) == 1 {
-			return ErrInsufficientAmount
-		}
-		account.Locked.Sub(account.Locked, amount)
+	var account *Account
+	err := storage.DB.Update(func(tx *bolt.Tx) error {
+		var err error
+		account, err = model.unlockAccount(tx, userID, symbol, amount, version)
+		return err
+	})
 
-		jsb, err = json.Marshal(&account)
-		if err != nil {
-			return err
+	if err != nil {
+		return nil, nil, err
+	}
+	return account, version, nil
+}
+
+// TransferFromLockAccount 从锁定账户转账
+func (model *AccountModel) TransferFromLockAccount(from, to int64, symbol string,
+	amount *fmath.Amount, version *Version) (*Account, *Account, *Version, error) {
+
+	var toAccount *Account
+	var fromAccount *Account
+	err := storage.DB.Update(func(tx *bolt.Tx) error {
+		var err error
+		fromAccount, toAccount, err = model.transferFromLockAccount(tx, from, to, symbol, amount, version)
+		return err
+	})
+
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return fromAccount, toAccount, version, nil
+}
+
+// IsFrozen 用户是否被冻结, 任一账户冻结即视为冻结
+func (model *AccountModel) IsFrozen(userID int64) (bool, string, error) {
+	accounts, err := model.GetAccounts(userID)
+	if err != nil {
+		if err == storage.ErrNoBucket {
+			return false, "", nil
+		}
+		return false, "", err
+	}
+	for _, account := range accounts {
+		if account.Disable {
+			return true, account.DisableReason, nil
 		}
+	}
+	return false, "", nil
+}
 
-		if err = bucket.Put([]byte(symbol), jsb); err != nil {
-			return err
+// SetFrozen 冻结或解冻用户, 只更新已有的资产账户, 没有账户时返回ErrNoSuchTypeAccount
+// 冻结版本只记录一条, 写在symbols中第一个已有的资产账户下, 账户历史按用户查询, 不区分资产
+func (model *AccountModel) SetFrozen(userID int64, symbols []string, frozen bool,
+	reason string) (*Version, error) {
+
+	var version *Version
+	err := storage.DB.Update(func(tx *bolt.Tx) error {
+		for _, symbol := range symbols {
+			account, err := model.update(tx, userID, symbol, false, func(account *Account) error {
+				account.Disable = frozen
+				account.DisableReason = ""
+				if frozen {
+					account.DisableReason = reason
+				}
+				return nil
+			})
+			if err == ErrNoSuchTypeAccount {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if version != nil {
+				continue
+			}
+
+			version = &Version{Reason: ReasonUnfreeze, RefMemo: &reason}
+			if frozen {
+				version.Reason = ReasonFreeze
+			}
+			if err = model.appendVersion(tx, userID, account, version); err != nil {
+				return err
+			}
+		}
+		if version == nil {
+			return ErrNoSuchTypeAccount
 		}
 		return nil
 	})
-
 	if err != nil {
 		return nil, err
 	}
-	return &account, nil
+	return version, nil
 }
 
-// LockAccount 锁定账户资金
-func (model *AccountModel) LockAccount(userID int64, symbol string, amount *big.Float) (*Account, error) {
-	var account Account
-	key := strconv.FormatInt(userID, 10)
+// Adjust 人工调账, delta为正数时增加余额, 为负数时扣除余额, 余额不足时返回错误
+func (model *AccountModel) Adjust(userID int64, symbol string, delta *fmath.Amount,
+	version *Version) (*Account, *Version, error) {
+
+	var account *Account
 	err := storage.DB.Update(func(tx *bolt.Tx) error {
-		bucket, err := storage.EnsureBucketExists(tx, "accounts", key)
+		var err error
+		account, err = model.update(tx, userID, symbol, delta.Sign() > 0, func(account *Account) error {
+			amount := fmath.Add(account.Amount, delta)
+			if amount.Sign() < 0 {
+				return ErrInsufficientAmount
+			}
+			account.Amount = amount
+			return nil
+		})
 		if err != nil {
 			return err
 		}
+		version.Balance = delta
+		version.Reason = ReasonAdjustment
+		return model.appendVersion(tx, userID, account, version)
+	})
 
-		jsb := bucket.Get([]byte(symbol))
-		if jsb == nil {
-			return ErrNoSuchTypeAccount
-		}
+	if err != nil {
+		return nil, nil, err
+	}
+	return account, version, nil
+}
 
-		if err = json.Unmarshal(jsb, &account); err != nil {
-			return err
+// 存款操作
+func (model *AccountModel) depositAccount(tx *bolt.Tx, userID int64, symbol string, amount *fmath.Amount,
+	version *Version) (*Account, error) {
+
+	account, err := model.update(tx, userID, symbol, true, func(account *Account) error {
+		account.Amount.Add(account.Amount, amount)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return account, model.appendVersion(tx, userID, account, version)
+}
+
+// 取款操作
+func (model *AccountModel) withdrawAccount(tx *bolt.Tx, userID int64, symbol string, amount *fmath.Amount,
+	version *Version) (*Account, error) {
+
+	account, err := model.update(tx, userID, symbol, false, func(account *Account) error {
+		if amount.Cmp(account.Locked) == 1 {
+			return ErrInsufficientAmount
 		}
-		account.Normalization()
+		account.Locked.Sub(account.Locked, amount)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return account, model.appendVersion(tx, userID, account, version)
+}
+
+// 锁定资金
+func (model *AccountModel) lockAccount(tx *bolt.Tx, userID int64, symbol string, amount *fmath.Amount,
+	version *Version) (*Account, error) {
 
+	account, err := model.update(tx, userID, symbol, false, func(account *Account) error {
 		if amount.Cmp(account.Amount) == 1 {
 			return ErrInsufficientAmount
 		}
 		account.Locked.Add(account.Locked, amount)
 		account.Amount.Sub(account.Amount, amount)
-
-		jsb, err = json.Marshal(&account)
-		if err != nil {
-			return err
-		}
-
-		if err = bucket.Put([]byte(symbol), jsb); err != nil {
-			return err
-		}
 		return nil
 	})
-
 	if err != nil {
 		return nil, err
 	}
-	return &account, nil
+	return account, model.appendVersion(tx, userID, account, version)
 }
 
-// UnlockAccount 解锁账户资金
-func (model *AccountModel) UnlockAccount(userID int64, symbol string, amount *big.Float) (*Account, error) {
-	var account Account
-	key := strconv.FormatInt(userID, 10)
-	err := storage.DB.Update(func(tx *bolt.Tx) error {
-		bucket, err := storage.EnsureBucketExists(tx, "accounts", key)
-		if err != nil {
-			return err
-		}
+// 解锁资金
+func (model *AccountModel) unlockAccount(tx *bolt.Tx, userID int64, symbol string, amount *fmath.Amount,
+	version *Version) (*Account, error) {
 
-		jsb := bucket.Get([]byte(symbol))
-		if jsb == nil {
-			return ErrNoSuchTypeAccount
+	account, err := model.update(tx, userID, symbol, false, func(account *Account) error {
+		if amount.Cmp(account.Locked) == 1 {
+			return ErrInsufficientAmount
 		}
+		account.Locked.Sub(account.Locked, amount)
+		account.Amount.Add(account.Amount, amount)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return account, model.appendVersion(tx, userID, account, version)
+}
 
-		if err = json.Unmarshal(jsb, &account); err != nil {
-			return err
-		}
-		account.Normalization()
+// 从锁定账户转账
+func (model *AccountModel) transferFromLockAccount(tx *bolt.Tx, from, to int64, symbol string,
+	amount *fmath.Amount, version *Version) (*Account, *Account, error) {
 
+	// 扣除锁定资产
+	fromAccount, err := model.update(tx, from, symbol, false, func(account *Account) error {
 		if amount.Cmp(account.Locked) == 1 {
 			return ErrInsufficientAmount
 		}
 		account.Locked.Sub(account.Locked, amount)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 转移锁定资产
+	toAccount, err := model.update(tx, to, symbol, true, func(account *Account) error {
 		account.Amount.Add(account.Amount, amount)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return fromAccount, toAccount, model.appendVersion(tx, to, toAccount, version)
+}
 
-		jsb, err = json.Marshal(&account)
-		if err != nil {
-			return err
+// 继承同一用户其他账户的冻结状态
+func (model *AccountModel) inheritFrozen(bucket *bolt.Bucket, account *Account) error {
+	return bucket.ForEach(func(k, v []byte) error {
+		if v == nil || account.Disable {
+			return nil
 		}
-
-		if err = bucket.Put([]byte(symbol), jsb); err != nil {
+		var other Account
+		if err := json.Unmarshal(v, &other); err != nil {
 			return err
 		}
+		account.Disable = other.Disable
+		account.DisableReason = other.DisableReason
 		return nil
 	})
+}
+
+// 更新账户信息
+func (model *AccountModel) update(tx *bolt.Tx, userID int64, symbol string, create bool,
+	handler func(*Account) error) (*Account, error) {
 
+	key := strconv.FormatInt(userID, 10)
+	bucket, err := storage.EnsureBucketExists(tx, "accounts", key)
 	if err != nil {
 		return nil, err
 	}
-	return &account, nil
-}
 
-// TransferFromLockAccount 从锁定账户转账
-func (model *AccountModel) TransferFromLockAccount(from, to int64, symbol string,
-	amount *big.Float) (*Account, *Account, error) {
-
-	var toAccount Account
-	var fromAccount Account
-	toKey := strconv.FormatInt(to, 10)
-	fromKey := strconv.FormatInt(from, 10)
-	err := storage.DB.Update(func(tx *bolt.Tx) error {
-		// 获取桶
-		toBucket, err := storage.EnsureBucketExists(tx, "accounts", toKey)
-		if err != nil {
-			return err
-		}
-
-		fromBucket, err := storage.EnsureBucketExists(tx, "accounts", fromKey)
-		if err != nil {
-			return err
+	var account Account
+	jsb := bucket.Get([]byte(symbol))
+	if jsb == nil {
+		if !create {
+			return nil, ErrNoSuchTypeAccount
 		}
+		account.Symbol = symbol
+		account.Amount = fmath.Zero()
+		account.Locked = fmath.Zero()
 
-		// 获取账户信息
-		jsb := fromBucket.Get([]byte(symbol))
-		if jsb == nil {
-			return ErrNoSuchTypeAccount
-		}
-		if err = json.Unmarshal(jsb, &fromAccount); err != nil {
-			return err
+		// 新账户继承用户的冻结状态
+		if err = model.inheritFrozen(bucket, &account); err != nil {
+			return nil, err
 		}
-		fromAccount.Normalization()
-
-		if amount.Cmp(fromAccount.Locked) == 1 {
-			return ErrInsufficientAmount
+	} else {
+		if err = json.Unmarshal(jsb, &account); err != nil {
+			return nil, err
 		}
-		fromAccount.Locked.Sub(fromAccount.Locked, amount)
+	}
 
-		jsb, err = json.Marshal(&fromAccount)
-		if err != nil {
-			return err
-		}
+	amount := fmath.Zero().Set(account.Amount)
+	locked := fmath.Zero().Set(account.Locked)
+	if err = handler(&account); err != nil {
+		return nil, err
+	}
 
-		if err = fromBucket.Put([]byte(symbol), jsb); err != nil {
-			return err
-		}
+	jsb, err = json.Marshal(&account)
+	if err != nil {
+		return nil, err
+	}
 
-		// 转移锁定资产
-		jsb = toBucket.Get([]byte(symbol))
-		if jsb == nil {
-			toAccount.Symbol = symbol
-			toAccount.Amount = amount
-			toAccount.Locked = big.NewFloat(0)
-		} else {
-			if err = json.Unmarshal(jsb, &toAccount); err != nil {
-				return err
-			}
-			toAccount.Normalization()
-			toAccount.Amount.Add(toAccount.Amount, amount)
-		}
+	if err = bucket.Put([]byte(symbol), jsb); err != nil {
+		return nil, err
+	}
 
-		jsb, err = json.Marshal(&toAccount)
-		if err != nil {
-			return err
+	// 更新资产总量
+	amount.Sub(account.Amount, amount)
+	locked.Sub(account.Locked, locked)
+	if amount.Sign() != 0 || locked.Sign() != 0 {
+		statsModel := StatsModel{}
+		if err = statsModel.addTotals(tx, symbol, amount, locked); err != nil {
+			return nil, err
 		}
+	}
+	return &account, nil
+}
 
-		if err = toBucket.Put([]byte(symbol), jsb); err != nil {
-			return err
-		}
+// 追加账户版本
+func (model *AccountModel) appendVersion(tx *bolt.Tx, userID int64, account *Account, version *Version) error {
+	if version == nil {
 		return nil
-	})
-
-	if err != nil {
-		return nil, nil, err
 	}
-	return &fromAccount, &toAccount, nil
+	version.Symbol = account.Symbol
+	version.Amount = fmath.Zero().Set(account.Amount)
+	versionModel := AccountVersionModel{}
+	if err := versionModel.insertVersion(tx, userID, version); err != nil {
+		return err
+	}
+	statsModel := StatsModel{}
+	return statsModel.recordVersion(tx, userID, version)
 }
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"testing"
+
+	"luckybot/app/fmath"
+)
+
+func TestSetFrozen(t *testing.T) {
+	openTestDB(t)
+	model := AccountModel{}
+	symbols := []string{"ETH", "BTC"}
+
+	// 没有账户时不创建账户
+	if _, err := model.SetFrozen(1, symbols, true, "risk"); err != ErrNoSuchTypeAccount {
+		t.Fatalf("expected ErrNoSuchTypeAccount, got %v", err)
+	}
+	if _, err := model.GetAccount(1, "BTC"); err == nil {
+		t.Fatal("freeze should not create accounts")
+	}
+
+	// 只更新已有账户, 版本写在已有账户下
+	_, _, err := model.Deposit(1, "BTC", fmath.NewAmount(100), &Version{
+		Balance: fmath.NewAmount(100),
+		Reason:  ReasonDeposit,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, err := model.SetFrozen(1, symbols, true, "risk")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version.Symbol != "BTC" || version.Reason != ReasonFreeze {
+		t.Fatalf("unexpected freeze version, %+v", version)
+	}
+	if _, err = model.GetAccount(1, "ETH"); err != ErrNoSuchTypeAccount {
+		t.Fatalf("freeze should not create ETH account, %v", err)
+	}
+
+	// 新建账户继承冻结状态
+	account, _, err := model.Deposit(1, "ETH", fmath.NewAmount(5), &Version{
+		Balance: fmath.NewAmount(5),
+		Reason:  ReasonDeposit,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !account.Disable || account.DisableReason != "risk" {
+		t.Fatalf("new account should inherit frozen state, %+v", account)
+	}
+
+	// 解冻所有已有账户
+	if _, err = model.SetFrozen(1, symbols, false, "cleared"); err != nil {
+		t.Fatal(err)
+	}
+	if frozen, _, err := model.IsFrozen(1); err != nil || frozen {
+		t.Fatalf("IsFrozen = %v, %v, want false", frozen, err)
+	}
+}
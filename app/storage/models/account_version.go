@@ -2,7 +2,6 @@ package models
 
 import (
 	"encoding/json"
-	"math/big"
 	"strconv"
 	"time"
 
@@ -24,25 +23,32 @@ const (
 	ReasonWithdraw               // 提现
 	ReasonWithdrawSuccess        // 提现成功
 	ReasonWithdrawFailure        // 提现失败
+	ReasonTransferIn             // 转账收入
+	ReasonTransferOut            // 转账支出
+	ReasonFreeze                 // 冻结账户
+	ReasonUnfreeze               // 解冻账户
+	ReasonAdjustment             // 人工调账
 )
 
 // Version 版本信息
 type Version struct {
-	ID              uint64     `json:"id"`                           // 版本ID
-	Symbol          string     `json:"symbol"`                       // 代币符号
-	Balance         *big.Float `json:"balance,omitempty"`            // 余额变化
-	Locked          *big.Float `json:"locked,omitempty"`             // 锁定变化
-	Fee             *big.Float `json:"fee,omitempty"`                // 手续费
-	Amount          *big.Float `json:"amount"`                       // 剩余金额
-	Timestamp       int64      `json:"timestamp"`                    // 时间戳
-	Reason          Reason     `json:"reason"`                       // 触发原因
-	RefLuckyMoneyID *uint64    `json:"ref_lucky_money_id,omitempty"` // 关联红包ID
-	RefBlockHeight  *uint64    `json:"ref_block_height,omitempty"`   // 关联区块高度
-	RefTxID         *string    `json:"ref_tx_id,omitempty"`          // 关联交易ID
-	RefUserID       *int64     `json:"ref_user_id,omitempty"`        // 关联用户ID
-	RefUserName     *string    `json:"ref_user_name,omitempty"`      // 关联用户名
-	RefAddress      *string    `json:"ref_address,omitempty"`        // 关联地址
-	RefMemo         *string    `json:"ref_memo,omitempty"`           // 关联备注信息
+	ID              uint64        `json:"id"`                           // 版本ID
+	Symbol          string        `json:"symbol"`                       // 代币符号
+	Balance         *fmath.Amount `json:"balance,omitempty"`            // 余额变化
+	Locked          *fmath.Amount `json:"locked,omitempty"`             // 锁定变化
+	Fee             *fmath.Amount `json:"fee,omitempty"`                // 手续费
+	Amount          *fmath.Amount `json:"amount"`                       // 剩余金额
+	Timestamp       int64         `json:"timestamp"`                    // 时间戳
+	Reason          Reason        `json:"reason"`                       // 触发原因
+	RefLuckyMoneyID *uint64       `json:"ref_lucky_money_id,omitempty"` // 关联红包ID
+	RefBlockHeight  *uint64       `json:"ref_block_height,omitempty"`   // 关联区块高度
+	RefTxID         *string       `json:"ref_tx_id,omitempty"`          // 关联交易ID
+	RefUserID       *int64        `json:"ref_user_id,omitempty"`        // 关联用户ID
+	RefUserName     *string       `json:"ref_user_name,omitempty"`      // 关联用户名
+	RefAddress      *string       `json:"ref_address,omitempty"`        // 关联地址
+	RefMemo         *string       `json:"ref_memo,omitempty"`           // 关联备注信息
+	RefAdmin        *string       `json:"ref_admin,omitempty"`          // 关联管理员
+	RefTicket       *string       `json:"ref_ticket,omitempty"`         // 关联工单
 }
 
 // ********************** 结构图 **********************
@@ -58,34 +64,26 @@ type Version struct {
 type AccountVersionModel struct {
 }
 
-// InsertVersion 插入版本
-func (model *AccountVersionModel) InsertVersion(userID int64, version *Version) (*Version, error) {
+// 插入版本
+func (model *AccountVersionModel) insertVersion(tx *bolt.Tx, userID int64, version *Version) error {
 	key := strconv.FormatInt(userID, 10)
-	version.Timestamp = time.Now().UTC().Unix()
-	err := storage.DB.Update(func(tx *bolt.Tx) error {
-		bucket, err := storage.EnsureBucketExists(tx, "account_versions", key)
-		if err != nil {
-			return err
-		}
-
-		seq, err := bucket.NextSequence()
-		if err != nil {
-			return err
-		}
-
-		version.ID = seq
-		jsb, err := json.Marshal(version)
-		if err != nil {
-			return err
-		}
-		return bucket.Put([]byte(strconv.FormatUint(seq, 10)), jsb)
-	})
+	bucket, err := storage.EnsureBucketExists(tx, "account_versions", key)
+	if err != nil {
+		return err
+	}
 
+	seq, err := bucket.NextSequence()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return version, nil
+	version.ID = seq
+	version.Timestamp = time.Now().UTC().Unix()
+	jsb, err := json.Marshal(version)
+	if err != nil {
+		return err
+	}
+	return bucket.Put([]byte(strconv.FormatUint(seq, 10)), jsb)
 }
 
 // GetVersions 获取版本
@@ -148,18 +146,6 @@ func (model *AccountVersionModel) GetVersions(userID int64, offset, limit uint,
 		if err = json.Unmarshal(jsb, &version); err != nil {
 			return nil, 0, err
 		}
-		if version.Balance != nil {
-			version.Balance.SetPrec(fmath.Prec())
-		}
-		if version.Locked != nil {
-			version.Locked.SetPrec(fmath.Prec())
-		}
-		if version.Fee != nil {
-			version.Fee.SetPrec(fmath.Prec())
-		}
-		if version.Amount != nil {
-			version.Amount.SetPrec(fmath.Prec())
-		}
 		versions = append(versions, &version)
 	}
 	return versions, sum, nil
@@ -0,0 +1,347 @@
+package models
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"sort"
+
+	"github.com/boltdb/bolt"
+	"luckybot/app/storage"
+)
+
+// ********************** 结构图 **********************
+// {
+//	"admins": {
+// 		<username>: <admin>	// 管理员信息
+//	},
+//	"admin_sessions": {
+// 		<session_id>: <session>	// 管理员会话
+//	},
+//	"meta": {
+// 		"admin_token_key": <key>	// 会话签名密钥
+//	}
+// }
+// ***************************************************
+
+var (
+	// ErrAdminExists 管理员已存在
+	ErrAdminExists = errors.New("admin already exists")
+	// ErrAdminNotFound 管理员不存在
+	ErrAdminNotFound = errors.New("admin not found")
+	// ErrSessionNotFound 会话不存在
+	ErrSessionNotFound = errors.New("session not found")
+	// ErrCodeUsed 验证码已使用
+	ErrCodeUsed = errors.New("code already used")
+)
+
+// Admin 管理员
+type Admin struct {
+	Username     string `json:"username"`      // 用户名
+	PasswordHash string `json:"password_hash"` // 密码哈希
+	TOTPSecret   string `json:"totp_secret"`   // 两步验证密钥
+	Role         string `json:"role"`          // 角色
+	Disabled     bool   `json:"disabled"`      // 是否禁用
+	CreatedAt    int64  `json:"created_at"`    // 创建时间
+	TOTPCounter  int64  `json:"totp_counter"`  // 最近使用的验证码计数
+}
+
+// AdminSession 管理员会话
+type AdminSession struct {
+	ID        string `json:"id"`         // 会话ID
+	Username  string `json:"username"`   // 用户名
+	IP        string `json:"ip"`         // 登录IP
+	CreatedAt int64  `json:"created_at"` // 创建时间
+	ExpiresAt int64  `json:"expires_at"` // 过期时间
+	Revoked   bool   `json:"revoked"`    // 是否注销
+}
+
+// AdminModel 管理员模型
+type AdminModel struct {
+}
+
+// Create 创建管理员
+func (model *AdminModel) Create(admin *Admin) error {
+	return storage.DB.Update(func(tx *bolt.Tx) error {
+		bucket, err := storage.EnsureBucketExists(tx, "admins")
+		if err != nil {
+			return err
+		}
+		if bucket.Get([]byte(admin.Username)) != nil {
+			return ErrAdminExists
+		}
+		return model.putAdmin(bucket, admin)
+	})
+}
+
+// Get 获取管理员
+func (model *AdminModel) Get(username string) (*Admin, error) {
+	var admin *Admin
+	err := storage.DB.View(func(tx *bolt.Tx) error {
+		var err error
+		admin, err = model.getAdmin(tx, username)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return admin, nil
+}
+
+// List 获取管理员列表
+func (model *AdminModel) List() ([]*Admin, error) {
+	admins := make([]*Admin, 0)
+	err := storage.DB.View(func(tx *bolt.Tx) error {
+		bucket, err := storage.GetBucketIfExists(tx, "admins")
+		if err != nil {
+			return err
+		}
+		return bucket.ForEach(func(k, v []byte) error {
+			var admin Admin
+			if err := json.Unmarshal(v, &admin); err != nil {
+				return err
+			}
+			admins = append(admins, &admin)
+			return nil
+		})
+	})
+	if err != nil && err != storage.ErrNoBucket {
+		return nil, err
+	}
+	sort.Slice(admins, func(i, j int) bool {
+		return admins[i].Username < admins[j].Username
+	})
+	return admins, nil
+}
+
+// Update 更新管理员信息, 同时注销该管理员的所有会话
+func (model *AdminModel) Update(username string, fn func(admin *Admin)) error {
+	return storage.DB.Update(func(tx *bolt.Tx) error {
+		admin, err := model.getAdmin(tx, username)
+		if err != nil {
+			return err
+		}
+		fn(admin)
+		admin.Username = username
+
+		bucket, err := storage.EnsureBucketExists(tx, "admins")
+		if err != nil {
+			return err
+		}
+		if err = model.putAdmin(bucket, admin); err != nil {
+			return err
+		}
+		return model.revokeSessions(tx, username)
+	})
+}
+
+// UseTOTPCounter 记录已使用的验证码计数, 不大于上次计数时返回错误
+func (model *AdminModel) UseTOTPCounter(username string, counter int64) error {
+	return storage.DB.Update(func(tx *bolt.Tx) error {
+		admin, err := model.getAdmin(tx, username)
+		if err != nil {
+			return err
+		}
+		if counter <= admin.TOTPCounter {
+			return ErrCodeUsed
+		}
+		admin.TOTPCounter = counter
+
+		bucket, err := storage.EnsureBucketExists(tx, "admins")
+		if err != nil {
+			return err
+		}
+		return model.putAdmin(bucket, admin)
+	})
+}
+
+// AddSession 添加会话
+func (model *AdminModel) AddSession(session *AdminSession) error {
+	return storage.DB.Update(func(tx *bolt.Tx) error {
+		bucket, err := storage.EnsureBucketExists(tx, "admin_sessions")
+		if err != nil {
+			return err
+		}
+		return model.putSession(bucket, session)
+	})
+}
+
+// GetSession 获取会话
+func (model *AdminModel) GetSession(id string) (*AdminSession, error) {
+	var session AdminSession
+	err := storage.DB.View(func(tx *bolt.Tx) error {
+		bucket, err := storage.GetBucketIfExists(tx, "admin_sessions")
+		if err != nil {
+			if err == storage.ErrNoBucket {
+				return ErrSessionNotFound
+			}
+			return err
+		}
+		data := bucket.Get([]byte(id))
+		if data == nil {
+			return ErrSessionNotFound
+		}
+		return json.Unmarshal(data, &session)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// RevokeSession 注销会话
+func (model *AdminModel) RevokeSession(id string) error {
+	return storage.DB.Update(func(tx *bolt.Tx) error {
+		bucket, err := storage.GetBucketIfExists(tx, "admin_sessions")
+		if err != nil {
+			if err == storage.ErrNoBucket {
+				return ErrSessionNotFound
+			}
+			return err
+		}
+		data := bucket.Get([]byte(id))
+		if data == nil {
+			return ErrSessionNotFound
+		}
+		var session AdminSession
+		if err = json.Unmarshal(data, &session); err != nil {
+			return err
+		}
+		session.Revoked = true
+		return model.putSession(bucket, &session)
+	})
+}
+
+// RevokeSessions 注销管理员的所有会话
+func (model *AdminModel) RevokeSessions(username string) error {
+	return storage.DB.Update(func(tx *bolt.Tx) error {
+		return model.revokeSessions(tx, username)
+	})
+}
+
+// RemoveExpiredSessions 删除过期会话
+func (model *AdminModel) RemoveExpiredSessions(now int64) error {
+	return storage.DB.Update(func(tx *bolt.Tx) error {
+		bucket, err := storage.GetBucketIfExists(tx, "admin_sessions")
+		if err != nil {
+			if err == storage.ErrNoBucket {
+				return nil
+			}
+			return err
+		}
+
+		expired := make([][]byte, 0)
+		err = bucket.ForEach(func(k, v []byte) error {
+			var session AdminSession
+			if err := json.Unmarshal(v, &session); err != nil || session.ExpiresAt <= now {
+				expired = append(expired, append([]byte{}, k...))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, key := range expired {
+			if err = bucket.Delete(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// TokenKey 获取会话签名密钥, 不存在时自动生成
+func (model *AdminModel) TokenKey() ([]byte, error) {
+	var key []byte
+	err := storage.DB.Update(func(tx *bolt.Tx) error {
+		bucket, err := storage.EnsureBucketExists(tx, "meta")
+		if err != nil {
+			return err
+		}
+		if data := bucket.Get([]byte("admin_token_key")); data != nil {
+			key = append([]byte{}, data...)
+			return nil
+		}
+
+		key = make([]byte, 32)
+		if _, err = rand.Read(key); err != nil {
+			return err
+		}
+		return bucket.Put([]byte("admin_token_key"), key)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// 获取管理员
+func (model *AdminModel) getAdmin(tx *bolt.Tx, username string) (*Admin, error) {
+	bucket, err := storage.GetBucketIfExists(tx, "admins")
+	if err != nil {
+		if err == storage.ErrNoBucket {
+			return nil, ErrAdminNotFound
+		}
+		return nil, err
+	}
+	data := bucket.Get([]byte(username))
+	if data == nil {
+		return nil, ErrAdminNotFound
+	}
+	var admin Admin
+	if err = json.Unmarshal(data, &admin); err != nil {
+		return nil, err
+	}
+	return &admin, nil
+}
+
+// 保存管理员
+func (model *AdminModel) putAdmin(bucket *bolt.Bucket, admin *Admin) error {
+	jsb, err := json.Marshal(admin)
+	if err != nil {
+		return err
+	}
+	return bucket.Put([]byte(admin.Username), jsb)
+}
+
+// 保存会话
+func (model *AdminModel) putSession(bucket *bolt.Bucket, session *AdminSession) error {
+	jsb, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	return bucket.Put([]byte(session.ID), jsb)
+}
+
+// 注销管理员的所有会话
+func (model *AdminModel) revokeSessions(tx *bolt.Tx, username string) error {
+	bucket, err := storage.GetBucketIfExists(tx, "admin_sessions")
+	if err != nil {
+		if err == storage.ErrNoBucket {
+			return nil
+		}
+		return err
+	}
+
+	sessions := make([]*AdminSession, 0)
+	err = bucket.ForEach(func(k, v []byte) error {
+		var session AdminSession
+		if err := json.Unmarshal(v, &session); err != nil {
+			return err
+		}
+		if session.Username == username && !session.Revoked {
+			sessions = append(sessions, &session)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		session.Revoked = true
+		if err = model.putSession(bucket, session); err != nil {
+			return err
+		}
+	}
+	return nil
+}
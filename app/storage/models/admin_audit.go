@@ -0,0 +1,109 @@
+package models
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/boltdb/bolt"
+	"luckybot/app/storage"
+)
+
+// ********************** 结构图 **********************
+// {
+//	"admin_audit": {
+// 		<seq>: <log>	// 管理员操作日志, 只追加不修改
+//	}
+// }
+// ***************************************************
+
+// AdminAuditLog 管理员操作日志
+type AdminAuditLog struct {
+	ID        uint64          `json:"id"`               // 日志ID
+	Username  string          `json:"username"`         // 用户名
+	Role      string          `json:"role,omitempty"`   // 角色
+	Action    string          `json:"action"`           // 操作接口
+	Params    json.RawMessage `json:"params,omitempty"` // 请求参数
+	IP        string          `json:"ip"`               // 来源IP
+	Status    int             `json:"status"`           // 响应状态码
+	Timestamp int64           `json:"timestamp"`        // 操作时间
+}
+
+// AdminAuditFilter 操作日志过滤条件
+type AdminAuditFilter struct {
+	Username string // 用户名
+	Action   string // 操作接口
+}
+
+// 是否匹配
+func (filter *AdminAuditFilter) match(log *AdminAuditLog) bool {
+	if len(filter.Username) > 0 && filter.Username != log.Username {
+		return false
+	}
+	if len(filter.Action) > 0 && filter.Action != log.Action {
+		return false
+	}
+	return true
+}
+
+// AdminAuditModel 管理员操作日志模型
+type AdminAuditModel struct {
+}
+
+// Append 追加日志
+func (model *AdminAuditModel) Append(log *AdminAuditLog) error {
+	return storage.DB.Update(func(tx *bolt.Tx) error {
+		bucket, err := storage.EnsureBucketExists(tx, "admin_audit")
+		if err != nil {
+			return err
+		}
+
+		seq, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+		log.ID = seq
+		jsb, err := json.Marshal(log)
+		if err != nil {
+			return err
+		}
+		return bucket.Put([]byte(strconv.FormatUint(seq, 10)), jsb)
+	})
+}
+
+// GetLogs 获取日志, 按时间倒序返回
+func (model *AdminAuditModel) GetLogs(filter *AdminAuditFilter, offset, limit uint) ([]*AdminAuditLog, int, error) {
+	sum := 0
+	logs := make([]*AdminAuditLog, 0)
+	err := storage.DB.View(func(tx *bolt.Tx) error {
+		bucket, err := storage.GetBucketIfExists(tx, "admin_audit")
+		if err != nil {
+			if err != storage.ErrNoBucket {
+				return err
+			}
+			return nil
+		}
+
+		for i := bucket.Sequence(); i >= uint64(1); i-- {
+			data := bucket.Get([]byte(strconv.FormatUint(i, 10)))
+			if data == nil {
+				continue
+			}
+			var log AdminAuditLog
+			if err = json.Unmarshal(data, &log); err != nil {
+				return err
+			}
+			if !filter.match(&log) {
+				continue
+			}
+			if uint(sum) >= offset && uint(len(logs)) < limit {
+				logs = append(logs, &log)
+			}
+			sum++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return logs, sum, nil
+}
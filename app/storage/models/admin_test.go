@@ -0,0 +1,35 @@
+package models
+
+import "testing"
+
+func TestUseTOTPCounter(t *testing.T) {
+	openTestDB(t)
+	model := AdminModel{}
+	if err := model.Create(&Admin{Username: "root"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := model.UseTOTPCounter("root", 100); err != nil {
+		t.Fatal(err)
+	}
+	for _, counter := range []int64{100, 99} {
+		if err := model.UseTOTPCounter("root", counter); err != ErrCodeUsed {
+			t.Fatalf("reuse counter %d, expected ErrCodeUsed, got %v", counter, err)
+		}
+	}
+	if err := model.UseTOTPCounter("root", 101); err != nil {
+		t.Fatal(err)
+	}
+
+	// 计数保存在管理员信息中
+	admin, err := model.Get("root")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if admin.TOTPCounter != 101 {
+		t.Fatalf("totp counter = %d, want 101", admin.TOTPCounter)
+	}
+	if err = model.UseTOTPCounter("nobody", 1); err != ErrAdminNotFound {
+		t.Fatalf("expected ErrAdminNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,531 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"luckybot/app/fmath"
+	"luckybot/app/storage"
+)
+
+// DiscrepancyKind 差异类型
+type DiscrepancyKind string
+
+const (
+	DiscrepancyNegativeBalance   DiscrepancyKind = "negative_balance"   // 余额为负
+	DiscrepancyVersionGap        DiscrepancyKind = "version_gap"        // 版本记录缺失
+	DiscrepancyVersionMismatch   DiscrepancyKind = "version_mismatch"   // 版本余额不符
+	DiscrepancyMissingVersion    DiscrepancyKind = "missing_version"    // 缺少版本记录
+	DiscrepancyLockMismatch      DiscrepancyKind = "lock_mismatch"      // 锁定金额不符
+	DiscrepancyUnrefundedExpired DiscrepancyKind = "unrefunded_expired" // 过期红包未退还
+	DiscrepancyHistoryMismatch   DiscrepancyKind = "history_mismatch"   // 红包记录不符
+	DiscrepancyMissingDeposit    DiscrepancyKind = "missing_deposit"    // 充值未入账
+	DiscrepancySupplyMismatch    DiscrepancyKind = "supply_mismatch"    // 资产总量不符
+	DiscrepancyStatsMismatch     DiscrepancyKind = "stats_mismatch"     // 统计数据不符
+	DiscrepancyBrokenRecord      DiscrepancyKind = "broken_record"      // 记录无法解析
+)
+
+// Discrepancy 账目差异
+type Discrepancy struct {
+	Kind         DiscrepancyKind `json:"kind"`                     // 差异类型
+	UserID       int64           `json:"user_id,omitempty"`        // 用户ID
+	Symbol       string          `json:"symbol,omitempty"`         // 货币符号
+	LuckyMoneyID uint64          `json:"lucky_money_id,omitempty"` // 红包ID
+	Detail       string          `json:"detail"`                   // 详细信息
+}
+
+// String 格式化输出
+func (discrepancy *Discrepancy) String() string {
+	s := string(discrepancy.Kind)
+	if discrepancy.UserID != 0 {
+		s += fmt.Sprintf(" user=%d", discrepancy.UserID)
+	}
+	if len(discrepancy.Symbol) > 0 {
+		s += " symbol=" + discrepancy.Symbol
+	}
+	if discrepancy.LuckyMoneyID != 0 {
+		s += fmt.Sprintf(" lucky_money=%d", discrepancy.LuckyMoneyID)
+	}
+	return s + ": " + discrepancy.Detail
+}
+
+// AuditReport 对账报告
+type AuditReport struct {
+	Accounts      int            `json:"accounts"`      // 账户数量
+	Versions      int            `json:"versions"`      // 版本数量
+	LuckyMoneys   int            `json:"lucky_moneys"`  // 红包数量
+	Deposits      int            `json:"deposits"`      // 充值数量
+	Withdrawals   int            `json:"withdrawals"`   // 提现数量
+	Discrepancies []*Discrepancy `json:"discrepancies"` // 差异列表
+}
+
+// ErrSchemaOutdated 数据版本过旧
+var ErrSchemaOutdated = errors.New("schema outdated, start server once to migrate")
+
+// 用户资产键
+type holding struct {
+	userID int64
+	symbol string
+}
+
+// 对账上下文
+type auditor struct {
+	now       int64
+	expire    int64
+	precision func(symbol string) int
+	report    AuditReport
+
+	accounts map[holding]*Account      // 账户信息
+	locked   map[holding]*fmath.Amount // 应锁定金额
+	supply   map[string]*fmath.Amount  // 应有资产总量
+	deposits map[string]bool           // 已入账充值
+}
+
+// Audit 账目对账
+func Audit(precision func(symbol string) int, expire int64) (*AuditReport, error) {
+	a := auditor{
+		now:       time.Now().UTC().Unix(),
+		expire:    expire,
+		precision: precision,
+		report:    AuditReport{Discrepancies: make([]*Discrepancy, 0)},
+		accounts:  make(map[holding]*Account),
+		locked:    make(map[holding]*fmath.Amount),
+		supply:    make(map[string]*fmath.Amount),
+		deposits:  make(map[string]bool),
+	}
+
+	err := storage.DB.View(func(tx *bolt.Tx) error {
+		// 检查数据版本
+		version := 0
+		if bucket := tx.Bucket([]byte("meta")); bucket != nil {
+			version, _ = strconv.Atoi(string(bucket.Get([]byte("schema_version"))))
+		}
+		if version < SchemaVersion {
+			return ErrSchemaOutdated
+		}
+
+		steps := []func(*bolt.Tx) error{
+			a.auditAccounts,
+			a.auditVersions,
+			a.auditLuckyMoneys,
+			a.auditWithdrawals,
+			a.auditDeposits,
+			a.auditStats,
+		}
+		for _, step := range steps {
+			if err := step(tx); err != nil {
+				return err
+			}
+		}
+		a.auditLocked()
+		a.auditSupply()
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return &a.report, nil
+}
+
+// 添加差异
+func (a *auditor) add(kind DiscrepancyKind, userID int64, symbol string, id uint64, format string, args ...interface{}) {
+	a.report.Discrepancies = append(a.report.Discrepancies, &Discrepancy{
+		Kind:         kind,
+		UserID:       userID,
+		Symbol:       symbol,
+		LuckyMoneyID: id,
+		Detail:       fmt.Sprintf(format, args...),
+	})
+}
+
+// 格式化金额
+func (a *auditor) format(symbol string, amount *fmath.Amount) string {
+	return amount.Format(a.precision(symbol))
+}
+
+// 应锁定金额
+func (a *auditor) expectLocked(userID int64, symbol string, amount *fmath.Amount) {
+	key := holding{userID: userID, symbol: symbol}
+	if _, ok := a.locked[key]; !ok {
+		a.locked[key] = fmath.Zero()
+	}
+	a.locked[key].Add(a.locked[key], amount)
+}
+
+// 应有资产总量
+func (a *auditor) expectSupply(symbol string, amount *fmath.Amount) {
+	if _, ok := a.supply[symbol]; !ok {
+		a.supply[symbol] = fmath.Zero()
+	}
+	a.supply[symbol].Add(a.supply[symbol], amount)
+}
+
+// 检查账户
+func (a *auditor) auditAccounts(tx *bolt.Tx) error {
+	root := tx.Bucket([]byte("accounts"))
+	if root == nil {
+		return nil
+	}
+	return root.ForEach(func(k, v []byte) error {
+		userID, err := strconv.ParseInt(string(k), 10, 64)
+		if err != nil || v != nil {
+			return nil
+		}
+		return root.Bucket(k).ForEach(func(k, v []byte) error {
+			var account Account
+			if err := json.Unmarshal(v, &account); err != nil {
+				a.add(DiscrepancyBrokenRecord, userID, string(k), 0, "invalid account, %v", err)
+				return nil
+			}
+			a.report.Accounts++
+			a.accounts[holding{userID: userID, symbol: account.Symbol}] = &account
+
+			if account.Amount.Sign() < 0 || account.Locked.Sign() < 0 {
+				a.add(DiscrepancyNegativeBalance, userID, account.Symbol, 0, "amount=%s, locked=%s",
+					a.format(account.Symbol, account.Amount), a.format(account.Symbol, account.Locked))
+			}
+			return nil
+		})
+	})
+}
+
+// 检查账户版本
+func (a *auditor) auditVersions(tx *bolt.Tx) error {
+	latest := make(map[holding]*Version)
+	root := tx.Bucket([]byte("account_versions"))
+	if root != nil {
+		err := root.ForEach(func(k, v []byte) error {
+			userID, err := strconv.ParseInt(string(k), 10, 64)
+			if err != nil || v != nil {
+				return nil
+			}
+
+			bucket := root.Bucket(k)
+			for i := uint64(1); i <= bucket.Sequence(); i++ {
+				jsb := bucket.Get([]byte(strconv.FormatUint(i, 10)))
+				if jsb == nil {
+					a.add(DiscrepancyVersionGap, userID, "", 0, "missing version %d of %d", i, bucket.Sequence())
+					continue
+				}
+
+				var version Version
+				if err := json.Unmarshal(jsb, &version); err != nil {
+					a.add(DiscrepancyBrokenRecord, userID, "", 0, "invalid version %d, %v", i, err)
+					continue
+				}
+				a.report.Versions++
+				latest[holding{userID: userID, symbol: version.Symbol}] = &version
+
+				switch version.Reason {
+				case ReasonSystem, ReasonAdjustment:
+					if version.Balance != nil {
+						a.expectSupply(version.Symbol, version.Balance)
+					}
+				case ReasonDeposit:
+					if version.RefTxID != nil {
+						a.deposits[*version.RefTxID] = true
+					}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// 对比最新版本
+	keys := make(map[holding]bool)
+	for key := range a.accounts {
+		keys[key] = true
+	}
+	for _, key := range sortHoldings(keys) {
+		account := a.accounts[key]
+		version, ok := latest[key]
+		if !ok {
+			if account.Amount.Sign() != 0 || account.Locked.Sign() != 0 {
+				a.add(DiscrepancyMissingVersion, key.userID, key.symbol, 0, "account has balance but no version")
+			}
+			continue
+		}
+		if version.Amount == nil || version.Amount.Cmp(account.Amount) != 0 {
+			expected := "nil"
+			if version.Amount != nil {
+				expected = a.format(key.symbol, version.Amount)
+			}
+			a.add(DiscrepancyVersionMismatch, key.userID, key.symbol, 0,
+				"version %d amount=%s, account amount=%s", version.ID, expected, a.format(key.symbol, account.Amount))
+		}
+	}
+	return nil
+}
+
+// 检查红包
+func (a *auditor) auditLuckyMoneys(tx *bolt.Tx) error {
+	root := tx.Bucket([]byte("luckymoney"))
+	if root == nil {
+		return nil
+	}
+	return root.ForEach(func(k, v []byte) error {
+		id, err := strconv.ParseUint(string(k), 10, 64)
+		if err != nil || v != nil {
+			return nil
+		}
+
+		bucket := root.Bucket(k)
+		var base LuckyMoney
+		if err = json.Unmarshal(bucket.Get([]byte("base")), &base); err != nil {
+			a.add(DiscrepancyBrokenRecord, 0, "", id, "invalid lucky money, %v", err)
+			return nil
+		}
+		a.report.LuckyMoneys++
+
+		// 检查领取记录
+		received, _ := strconv.Atoi(string(bucket.Get([]byte("seq"))))
+		entries, claimed, sum := 0, 0, fmath.Zero()
+		if history := bucket.Bucket([]byte("history")); history != nil {
+			_ = history.ForEach(func(k, v []byte) error {
+				var item LuckyMoneyHistory
+				if err := json.Unmarshal(v, &item); err != nil {
+					a.add(DiscrepancyBrokenRecord, base.SenderID, base.Asset, id, "invalid history %s, %v", k, err)
+					return nil
+				}
+				entries++
+				if item.User != nil {
+					claimed++
+					sum.Add(sum, item.Value)
+				}
+				return nil
+			})
+		}
+		if entries != int(base.Number) || claimed != received || sum.Cmp(base.Received) != 0 {
+			a.add(DiscrepancyHistoryMismatch, base.SenderID, base.Asset, id,
+				"number=%d, entries=%d, seq=%d, claimed=%d, received=%s, claimed sum=%s",
+				base.Number, entries, received, claimed, a.format(base.Asset, base.Received), a.format(base.Asset, sum))
+		}
+
+		// 已过期红包
+		if bucket.Get([]byte("expired")) != nil {
+			return nil
+		}
+
+		// 计算剩余金额
+		balance := fmath.Sub(base.Amount, base.Received)
+		if !base.Lucky {
+			balance.Sub(fmath.Mul(base.Amount, int64(base.Number)), base.Received)
+		}
+		expireAt := base.ExpireTime(a.expire)
+		if received < int(base.Number) && (base.ExpireAt > 0 || a.expire > 0) && a.now >= expireAt {
+			a.add(DiscrepancyUnrefundedExpired, base.SenderID, base.Asset, id,
+				"expired %ds ago, balance=%s", a.now-expireAt, a.format(base.Asset, balance))
+		}
+		a.expectLocked(base.SenderID, base.Asset, balance)
+		return nil
+	})
+}
+
+// 检查提现
+func (a *auditor) auditWithdrawals(tx *bolt.Tx) error {
+	bucket := tx.Bucket([]byte("withdrawals"))
+	if bucket == nil {
+		return nil
+	}
+	return bucket.ForEach(func(k, v []byte) error {
+		var withdrawal Withdrawal
+		if err := json.Unmarshal(v, &withdrawal); err != nil {
+			a.add(DiscrepancyBrokenRecord, 0, "", 0, "invalid withdrawal %s, %v", k, err)
+			return nil
+		}
+		a.report.Withdrawals++
+
+		total := fmath.Add(withdrawal.Amount, withdrawal.Fee)
+		switch withdrawal.State {
+		case WithdrawStatePending, WithdrawStateSubmitted:
+			a.expectLocked(withdrawal.UserID, withdrawal.Symbol, total)
+		case WithdrawStateConfirmed:
+			a.expectSupply(withdrawal.Symbol, fmath.Neg(total))
+		}
+		return nil
+	})
+}
+
+// 检查充值
+func (a *auditor) auditDeposits(tx *bolt.Tx) error {
+	bucket := tx.Bucket([]byte("deposits"))
+	if bucket == nil {
+		return nil
+	}
+	return bucket.ForEach(func(k, v []byte) error {
+		var request struct {
+			Asset  string `json:"asset"`
+			Amount string `json:"amount"`
+			Memo   string `json:"memo"`
+		}
+		if err := json.Unmarshal(v, &request); err != nil {
+			a.add(DiscrepancyBrokenRecord, 0, "", 0, "invalid deposit %s, %v", k, err)
+			return nil
+		}
+		a.report.Deposits++
+
+		userID, _ := strconv.ParseInt(request.Memo, 10, 64)
+		if !a.deposits[string(k)] {
+			a.add(DiscrepancyMissingDeposit, userID, request.Asset, 0, "deposit %s has no account version", k)
+			return nil
+		}
+
+		amount, err := fmath.Parse(request.Amount, a.precision(request.Asset))
+		if err != nil {
+			a.add(DiscrepancyBrokenRecord, userID, request.Asset, 0, "invalid deposit amount %s, %v", k, err)
+			return nil
+		}
+		a.expectSupply(request.Asset, amount)
+		return nil
+	})
+}
+
+// 检查锁定金额
+func (a *auditor) auditLocked() {
+	keys := make(map[holding]bool)
+	for key := range a.accounts {
+		keys[key] = true
+	}
+	for key := range a.locked {
+		keys[key] = true
+	}
+
+	for _, key := range sortHoldings(keys) {
+		actual := fmath.Zero()
+		if account, ok := a.accounts[key]; ok {
+			actual = account.Locked
+		}
+		expected := fmath.Zero()
+		if amount, ok := a.locked[key]; ok {
+			expected = amount
+		}
+		if actual.Cmp(expected) != 0 {
+			a.add(DiscrepancyLockMismatch, key.userID, key.symbol, 0, "locked=%s, active lucky money and withdrawals=%s",
+				a.format(key.symbol, actual), a.format(key.symbol, expected))
+		}
+	}
+}
+
+// 检查资产总量
+func (a *auditor) auditSupply() {
+	actual := make(map[string]*fmath.Amount)
+	for key, account := range a.accounts {
+		if _, ok := actual[key.symbol]; !ok {
+			actual[key.symbol] = fmath.Zero()
+		}
+		actual[key.symbol].Add(actual[key.symbol], account.Amount)
+		actual[key.symbol].Add(actual[key.symbol], account.Locked)
+	}
+
+	symbols := make([]string, 0)
+	for symbol := range actual {
+		symbols = append(symbols, symbol)
+	}
+	for symbol := range a.supply {
+		if _, ok := actual[symbol]; !ok {
+			symbols = append(symbols, symbol)
+		}
+	}
+	sort.Strings(symbols)
+
+	for _, symbol := range symbols {
+		total := fmath.Zero()
+		if amount, ok := actual[symbol]; ok {
+			total = amount
+		}
+		expected := fmath.Zero()
+		if amount, ok := a.supply[symbol]; ok {
+			expected = amount
+		}
+		if total.Cmp(expected) != 0 {
+			a.add(DiscrepancySupplyMismatch, 0, symbol, 0,
+				"accounts total=%s, deposits, system credits and adjustments minus withdrawals=%s",
+				a.format(symbol, total), a.format(symbol, expected))
+		}
+	}
+}
+
+// 检查统计数据中的资产总量
+func (a *auditor) auditStats(tx *bolt.Tx) error {
+	actual := make(map[string]*AssetTotals)
+	for key, account := range a.accounts {
+		totals, ok := actual[key.symbol]
+		if !ok {
+			totals = &AssetTotals{Symbol: key.symbol, Amount: fmath.Zero(), Locked: fmath.Zero()}
+			actual[key.symbol] = totals
+		}
+		totals.Amount.Add(totals.Amount, account.Amount)
+		totals.Locked.Add(totals.Locked, account.Locked)
+	}
+
+	recorded := make(map[string]*AssetTotals)
+	if bucket, err := storage.GetBucketIfExists(tx, "stats", "totals"); err == nil {
+		err = bucket.ForEach(func(k, v []byte) error {
+			var totals AssetTotals
+			if err := json.Unmarshal(v, &totals); err != nil {
+				a.add(DiscrepancyBrokenRecord, 0, string(k), 0, "invalid stats totals, %v", err)
+				return nil
+			}
+			recorded[string(k)] = &totals
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	} else if err != storage.ErrNoBucket {
+		return err
+	}
+
+	symbols := make([]string, 0)
+	for symbol := range actual {
+		symbols = append(symbols, symbol)
+	}
+	for symbol := range recorded {
+		if _, ok := actual[symbol]; !ok {
+			symbols = append(symbols, symbol)
+		}
+	}
+	sort.Strings(symbols)
+
+	zero := AssetTotals{Amount: fmath.Zero(), Locked: fmath.Zero()}
+	for _, symbol := range symbols {
+		left, right := &zero, &zero
+		if totals, ok := actual[symbol]; ok {
+			left = totals
+		}
+		if totals, ok := recorded[symbol]; ok {
+			right = totals
+		}
+		if left.Amount.Cmp(right.Amount) != 0 || left.Locked.Cmp(right.Locked) != 0 {
+			a.add(DiscrepancyStatsMismatch, 0, symbol, 0,
+				"accounts amount=%s locked=%s, stats amount=%s locked=%s",
+				a.format(symbol, left.Amount), a.format(symbol, left.Locked),
+				a.format(symbol, right.Amount), a.format(symbol, right.Locked))
+		}
+	}
+	return nil
+}
+
+// 排序用户资产
+func sortHoldings(keys map[holding]bool) []holding {
+	result := make([]holding, 0, len(keys))
+	for key := range keys {
+		result = append(result, key)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].userID != result[j].userID {
+			return result[i].userID < result[j].userID
+		}
+		return result[i].symbol < result[j].symbol
+	})
+	return result
+}
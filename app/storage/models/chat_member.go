@@ -0,0 +1,103 @@
+package models
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"luckybot/app/storage"
+)
+
+// ********************** 结构图 **********************
+// {
+//	"chat_members": {
+// 		<chat_id>: {
+//			<user_id>: <timestamp>	// 群组成员, 记录确认时间
+//		}
+//	}
+// }
+// ***************************************************
+
+// ChatMemberTTL 群组成员缓存有效期(秒), 过期后需要重新确认
+const ChatMemberTTL = 3600
+
+// ChatMemberModel 群组成员模型
+type ChatMemberModel struct {
+}
+
+// 是否群组成员
+func (model *ChatMemberModel) isMember(tx *bolt.Tx, chatID, userID int64) (bool, error) {
+	return model.isFresh(tx, chatID, userID, ChatMemberTTL)
+}
+
+// 成员缓存是否在有效期内
+func (*ChatMemberModel) isFresh(tx *bolt.Tx, chatID, userID, ttl int64) (bool, error) {
+	bucket, err := storage.GetBucketIfExists(tx, "chat_members", strconv.FormatInt(chatID, 10))
+	if err != nil {
+		if err != storage.ErrNoBucket {
+			return false, err
+		}
+		return false, nil
+	}
+	return memberFresh(bucket.Get([]byte(strconv.FormatInt(userID, 10))), ttl), nil
+}
+
+// 成员缓存是否在有效期内, 旧数据没有确认时间视为过期
+func memberFresh(value []byte, ttl int64) bool {
+	if len(value) == 0 {
+		return false
+	}
+	timestamp, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil {
+		return false
+	}
+	return time.Now().Unix()-timestamp < ttl
+}
+
+// IsMember 是否群组成员
+func (model *ChatMemberModel) IsMember(chatID, userID int64) (bool, error) {
+	var member bool
+	err := storage.DB.View(func(tx *bolt.Tx) error {
+		var err error
+		member, err = model.isMember(tx, chatID, userID)
+		return err
+	})
+	return member, err
+}
+
+// AddMember 添加群组成员, 刷新确认时间
+func (model *ChatMemberModel) AddMember(chatID, userID int64) error {
+	// 有效期过半才刷新, 避免每条群组消息都开启写事务
+	fresh := false
+	err := storage.DB.View(func(tx *bolt.Tx) error {
+		var err error
+		fresh, err = model.isFresh(tx, chatID, userID, ChatMemberTTL/2)
+		return err
+	})
+	if err != nil || fresh {
+		return err
+	}
+
+	return storage.DB.Batch(func(tx *bolt.Tx) error {
+		bucket, err := storage.EnsureBucketExists(tx, "chat_members", strconv.FormatInt(chatID, 10))
+		if err != nil {
+			return err
+		}
+		member := []byte(strconv.FormatInt(userID, 10))
+		return bucket.Put(member, []byte(strconv.FormatInt(time.Now().Unix(), 10)))
+	})
+}
+
+// RemoveMember 移除群组成员
+func (*ChatMemberModel) RemoveMember(chatID, userID int64) error {
+	return storage.DB.Update(func(tx *bolt.Tx) error {
+		bucket, err := storage.GetBucketIfExists(tx, "chat_members", strconv.FormatInt(chatID, 10))
+		if err != nil {
+			if err != storage.ErrNoBucket {
+				return err
+			}
+			return nil
+		}
+		return bucket.Delete([]byte(strconv.FormatInt(userID, 10)))
+	})
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"luckybot/app/storage"
+)
+
+func TestChatMemberTTL(t *testing.T) {
+	openTestDB(t)
+	model := ChatMemberModel{}
+	if err := model.AddMember(-100, 1); err != nil {
+		t.Fatal(err)
+	}
+	if member, err := model.IsMember(-100, 1); err != nil || !member {
+		t.Fatalf("IsMember = %v, %v, want true", member, err)
+	}
+
+	// 过期和旧数据视为非成员
+	for _, value := range []string{strconv.FormatInt(time.Now().Unix()-ChatMemberTTL, 10), ""} {
+		err := storage.DB.Update(func(tx *bolt.Tx) error {
+			bucket, err := storage.EnsureBucketExists(tx, "chat_members", "-100")
+			if err != nil {
+				return err
+			}
+			return bucket.Put([]byte("1"), []byte(value))
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if member, err := model.IsMember(-100, 1); err != nil || member {
+			t.Fatalf("IsMember with %q = %v, %v, want false", value, member, err)
+		}
+	}
+
+	// 重新确认后刷新
+	if err := model.AddMember(-100, 1); err != nil {
+		t.Fatal(err)
+	}
+	if member, err := model.IsMember(-100, 1); err != nil || !member {
+		t.Fatalf("IsMember after refresh = %v, %v, want true", member, err)
+	}
+}
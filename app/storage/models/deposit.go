@@ -4,9 +4,20 @@ import (
 	"errors"
 
 	"github.com/boltdb/bolt"
+	"luckybot/app/fmath"
 	"luckybot/app/storage"
 )
 
+// ********************** 结构图 **********************
+// {
+//	"deposits": {
+// 		<txid>: <data>	// 原始充值数据
+//	}
+// ***************************************************
+
+// ErrRepeatDeposit 重复充值
+var ErrRepeatDeposit = errors.New("repeat deposit")
+
 // DepositModel 充值模型
 type DepositModel struct {
 }
@@ -21,18 +32,32 @@ func (model *DepositModel) Exist(txid string) bool {
 	return ret
 }
 
-// Add 添加充值记录
-func (model *DepositModel) Add(txid string, data []byte) error {
-	return storage.DB.Update(func(tx *bolt.Tx) error {
+// Credit 充值入账, 在同一事务中添加充值记录和增加用户资产
+func (model *DepositModel) Credit(txid string, data []byte, userID int64, symbol string,
+	amount *fmath.Amount, version *Version) (*Account, *Version, error) {
+
+	var account *Account
+	err := storage.DB.Update(func(tx *bolt.Tx) error {
 		if model.exist(tx, txid) {
-			return errors.New("repeat deposit")
+			return ErrRepeatDeposit
 		}
 		bucket, err := storage.EnsureBucketExists(tx, "deposits")
 		if err != nil {
-			return nil
+			return err
+		}
+		if err = bucket.Put([]byte(txid), data); err != nil {
+			return err
 		}
-		return bucket.Put([]byte(txid), data)
+
+		accountModel := AccountModel{}
+		account, err = accountModel.depositAccount(tx, userID, symbol, amount, version)
+		return err
 	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+	return account, version, nil
 }
 
 // 查询TxID是否存在
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/boltdb/bolt"
+	"luckybot/app/storage"
+)
+
+// ********************** 结构图 **********************
+// {
+//	"deposit_nonces": {
+// 		<nonce>: <timestamp>	// 已使用的随机数
+//	}
+// ***************************************************
+
+// ErrNonceUsed 随机数已使用
+var ErrNonceUsed = errors.New("nonce already used")
+
+// DepositNonceModel 充值随机数模型
+type DepositNonceModel struct {
+}
+
+// Use 使用随机数, 同时清理早于expireBefore的记录
+func (model *DepositNonceModel) Use(nonce string, timestamp, expireBefore int64) error {
+	return storage.DB.Update(func(tx *bolt.Tx) error {
+		bucket, err := storage.EnsureBucketExists(tx, "deposit_nonces")
+		if err != nil {
+			return err
+		}
+
+		// 清理过期记录
+		expired := make([][]byte, 0)
+		cursor := bucket.Cursor()
+		for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+			value, err := strconv.ParseInt(string(v), 10, 64)
+			if err != nil || value < expireBefore {
+				expired = append(expired, append([]byte{}, k...))
+			}
+		}
+		for _, key := range expired {
+			if err = bucket.Delete(key); err != nil {
+				return err
+			}
+		}
+
+		if bucket.Get([]byte(nonce)) != nil {
+			return ErrNonceUsed
+		}
+		return bucket.Put([]byte(nonce), []byte(strconv.FormatInt(timestamp, 10)))
+	})
+}
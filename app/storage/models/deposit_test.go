@@ -0,0 +1,66 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/boltdb/bolt"
+	"luckybot/app/fmath"
+	"luckybot/app/storage"
+)
+
+// 测试充值版本
+func newDepositVersion(amount *fmath.Amount) *Version {
+	return &Version{Balance: amount, Reason: ReasonDeposit}
+}
+
+func TestDepositCredit(t *testing.T) {
+	openTestDB(t)
+	model := DepositModel{}
+	amount := fmath.NewAmount(100)
+
+	// 首次入账
+	account, _, err := model.Credit("tx1", []byte("{}"), 1, "BTC", amount, newDepositVersion(amount))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if account.Amount.Cmp(amount) != 0 {
+		t.Fatalf("amount = %s, want %s", account.Amount, amount)
+	}
+	if !model.Exist("tx1") {
+		t.Fatal("deposit record not found")
+	}
+
+	// 重复入账
+	if _, _, err = model.Credit("tx1", []byte("{}"), 1, "BTC", amount, newDepositVersion(amount)); err != ErrRepeatDeposit {
+		t.Fatalf("expected ErrRepeatDeposit, got %v", err)
+	}
+	accountModel := AccountModel{}
+	if account, _ = accountModel.GetAccount(1, "BTC"); account.Amount.Cmp(amount) != 0 {
+		t.Fatalf("amount = %s after repeat deposit, want %s", account.Amount, amount)
+	}
+}
+
+func TestDepositCreditRollback(t *testing.T) {
+	openTestDB(t)
+
+	// 账户桶损坏时入账失败
+	err := storage.DB.Update(func(tx *bolt.Tx) error {
+		bucket, err := storage.EnsureBucketExists(tx, "accounts")
+		if err != nil {
+			return err
+		}
+		return bucket.Put([]byte("1"), []byte("broken"))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	model := DepositModel{}
+	amount := fmath.NewAmount(100)
+	if _, _, err = model.Credit("tx1", []byte("{}"), 1, "BTC", amount, newDepositVersion(amount)); err == nil {
+		t.Fatal("expected credit failure")
+	}
+	if model.Exist("tx1") {
+		t.Fatal("deposit record kept after failed credit")
+	}
+}
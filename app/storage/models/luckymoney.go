@@ -5,9 +5,9 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
-	"math"
-	"math/big"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/boltdb/bolt"
 	"luckybot/app/fmath"
@@ -17,55 +17,104 @@ import (
 // DefaultLuckyMoneyID 默认红包ID
 const DefaultLuckyMoneyID = 100000
 
+const (
+	// ScopeAnyone 所有人可领取
+	ScopeAnyone = ""
+	// ScopeFirstChat 仅限首发聊天
+	ScopeFirstChat = "chat"
+	// ScopeWhitelist 仅限指定群组
+	ScopeWhitelist = "list"
+)
+
 // LuckyMoney 红包信息
 type LuckyMoney struct {
-	ID         uint64     `json:"id"`          // 红包ID
-	SN         string     `json:"sn"`          // 唯一编号
-	SenderID   int64      `json:"sender_id"`   // 发送者
-	SenderName string     `json:"sender_name"` // 发送者名字
-	Asset      string     `json:"asset"`       // 资产类型
-	Amount     *big.Float `json:"amount"`      // 红包金额
-	Received   *big.Float `json:"received"`    // 领取金额
-	Number     uint32     `json:"number"`      // 红包个数
-	Lucky      bool       `json:"lucky"`       // 是否随机
-	Value      *big.Float `json:"value"`       // 单个价值
-	Active     bool       `json:"active"`      // 是否激活
-	Message    string     `json:"message"`     // 红包留言
-	Timestamp  int64      `json:"timestamp"`   // 时间戳
+	ID            uint64        `json:"id"`                       // 红包ID
+	SN            string        `json:"sn"`                       // 唯一编号
+	SenderID      int64         `json:"sender_id"`                // 发送者
+	SenderName    string        `json:"sender_name"`              // 发送者名字
+	Asset         string        `json:"asset"`                    // 资产类型
+	Amount        *fmath.Amount `json:"amount"`                   // 红包金额
+	Received      *fmath.Amount `json:"received"`                 // 领取金额
+	Number        uint32        `json:"number"`                   // 红包个数
+	Lucky         bool          `json:"lucky"`                    // 是否随机
+	Value         *fmath.Amount `json:"value"`                    // 单个价值
+	Active        bool          `json:"active"`                   // 是否激活
+	Message       string        `json:"message"`                  // 红包留言
+	Timestamp     int64         `json:"timestamp"`                // 时间戳
+	Scope         string        `json:"scope,omitempty"`          // 领取范围
+	Chats         []int64       `json:"chats,omitempty"`          // 群组白名单
+	ChatInstance  string        `json:"chat_instance,omitempty"`  // 首发聊天标识(旧数据)
+	ChatID        int64         `json:"chat_id,omitempty"`        // 首发群组ID
+	Password      string        `json:"password,omitempty"`       // 红包口令
+	RecipientID   int64         `json:"recipient_id,omitempty"`   // 专属用户ID
+	RecipientName string        `json:"recipient_name,omitempty"` // 专属用户名
+	ActivateAt    int64         `json:"activate_at,omitempty"`    // 开抢时间
+	ExpireAt      int64         `json:"expire_at,omitempty"`      // 过期时间
+	Distributor   string        `json:"distributor,omitempty"`    // 分配算法
+}
+
+// StartTime 开抢时间, 未设置时为创建时间
+func (luckyMoney *LuckyMoney) StartTime() int64 {
+	if luckyMoney.ActivateAt > luckyMoney.Timestamp {
+		return luckyMoney.ActivateAt
+	}
+	return luckyMoney.Timestamp
+}
+
+// ExpireTime 过期时间, 未设置时按默认有效期计算
+func (luckyMoney *LuckyMoney) ExpireTime(defaultExpire int64) int64 {
+	if luckyMoney.ExpireAt > 0 {
+		return luckyMoney.ExpireAt
+	}
+	return luckyMoney.StartTime() + defaultExpire
 }
 
-// Normalization 标准化
-func (luckymoney *LuckyMoney) Normalization() {
-	if luckymoney.Value != nil {
-		luckymoney.Value.SetPrec(fmath.Prec())
+// ScopeChats 领取范围内的群组, 首发聊天红包在发出前没有群组
+func (luckyMoney *LuckyMoney) ScopeChats() []int64 {
+	switch luckyMoney.Scope {
+	case ScopeFirstChat:
+		if luckyMoney.ChatID != 0 {
+			return []int64{luckyMoney.ChatID}
+		}
+	case ScopeWhitelist:
+		return luckyMoney.Chats
 	}
-	if luckymoney.Amount != nil {
-		luckymoney.Amount.SetPrec(fmath.Prec())
+	return nil
+}
+
+// 可以发送口令的群组, 包括群组白名单和首发群组
+func (luckyMoney *LuckyMoney) passwordChats() []int64 {
+	chats := make([]int64, 0, len(luckyMoney.Chats)+1)
+	chats = append(chats, luckyMoney.Chats...)
+	if luckyMoney.ChatID != 0 && !luckyMoney.inChats(luckyMoney.ChatID) {
+		chats = append(chats, luckyMoney.ChatID)
 	}
-	if luckymoney.Received != nil {
-		luckymoney.Received.SetPrec(fmath.Prec())
+	return chats
+}
+
+// 是否在群组白名单中
+func (luckyMoney *LuckyMoney) inChats(chatID int64) bool {
+	for _, id := range luckyMoney.Chats {
+		if id == chatID {
+			return true
+		}
 	}
+	return false
 }
 
 // LuckyMoneyUser 红包用户
 type LuckyMoneyUser struct {
-	UserID    int64  `json:"user_id"`    // 用户ID
-	FirstName string `json:"first_name"` // 用户名
+	UserID    int64  `json:"user_id"`             // 用户ID
+	FirstName string `json:"first_name"`          // 用户名
+	UserName  string `json:"user_name,omitempty"` // 用户账号
 }
 
 // LuckyMoneyHistory 红包记录
 type LuckyMoneyHistory struct {
-	Value *big.Float      `json:"value"`          // 红包金额
+	Value *fmath.Amount   `json:"value"`          // 红包金额
 	User  *LuckyMoneyUser `json:"user,omitempty"` // 用户信息
 }
 
-// Normalization 标准化
-func (history *LuckyMoneyHistory) Normalization() {
-	if history.Value != nil {
-		history.Value.SetPrec(fmath.Prec())
-	}
-}
-
 var (
 	// ErrNothingLeft 领完了
 	ErrNothingLeft = errors.New("nothing left")
@@ -79,6 +128,14 @@ var (
 	ErrPermissionDenied = errors.New("permission denied")
 	// ErrLuckyMoneydExpired 红包已过期
 	ErrLuckyMoneydExpired = errors.New("lucky money expired")
+	// ErrOutOfScope 不在领取范围
+	ErrOutOfScope = errors.New("out of scope")
+	// ErrPasswordRequired 需要口令
+	ErrPasswordRequired = errors.New("password required")
+	// ErrWrongPassword 口令错误
+	ErrWrongPassword = errors.New("wrong password")
+	// ErrNotStarted 尚未开抢
+	ErrNotStarted = errors.New("not started")
 )
 
 // ********************** 结构图 **********************
@@ -92,6 +149,12 @@ var (
 //			"users": {					// 红包已领用户
 //				"user_id": ""
 //			}
+//			"unlocked": {				// 口令验证用户
+//				"user_id": ""
+//			}
+//			"messages": {				// 开抢前的内联消息
+//				<inline_message_id>: ""
+//			}
 // 			"history": {				// 红包领取记录
 // 				"seq": types.LuckyMoneyHistory
 // 			}
@@ -106,6 +169,13 @@ var (
 //		"history": {					// 用户历史红包
 //			<user_id>: array
 //		},
+//		"passwords": {					// 红包口令索引
+//			<chat_id>: {
+//				<password>: {
+//					<sid>: ""
+//				}
+//			}
+//		},
 //		"sequeue": 0,					// 红包ID生成序列
 //		"latest_expired": 0,		    // 最新过期红包ID
 // 	}
@@ -166,11 +236,77 @@ func (model *LuckyMoneyModel) moveToUserHistory(tx *bolt.Tx, usdeID int64, sid s
 	return nil
 }
 
+// NormalizePassword 规范化口令
+func NormalizePassword(password string) string {
+	return strings.ToLower(strings.Join(strings.Fields(password), " "))
+}
+
+// 添加口令索引
+func (model *LuckyMoneyModel) addPasswordIndex(tx *bolt.Tx, chatID int64, password, sid string) error {
+	bucket, err := storage.EnsureBucketExists(tx, "luckymoney", "passwords",
+		strconv.FormatInt(chatID, 10), password)
+	if err != nil {
+		return err
+	}
+	return bucket.Put([]byte(sid), []byte(""))
+}
+
+// 删除口令索引
+func (model *LuckyMoneyModel) removePasswordIndex(tx *bolt.Tx, base *LuckyMoney, sid string) error {
+	if len(base.Password) == 0 {
+		return nil
+	}
+	for _, chatID := range base.passwordChats() {
+		root, err := storage.GetBucketIfExists(tx, "luckymoney", "passwords", strconv.FormatInt(chatID, 10))
+		if err != nil {
+			if err != storage.ErrNoBucket {
+				return err
+			}
+			continue
+		}
+		bucket := root.Bucket([]byte(base.Password))
+		if bucket == nil {
+			continue
+		}
+		if err = bucket.Delete([]byte(sid)); err != nil {
+			return err
+		}
+		if k, _ := bucket.Cursor().First(); k == nil {
+			if err = root.DeleteBucket([]byte(base.Password)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// 标记口令验证
+func (model *LuckyMoneyModel) unlock(tx *bolt.Tx, sid string, userID int64) error {
+	bucket, err := storage.EnsureBucketExists(tx, "luckymoney", sid, "unlocked")
+	if err != nil {
+		return err
+	}
+	return bucket.Put([]byte(strconv.FormatInt(userID, 10)), []byte(""))
+}
+
+// 是否通过口令验证
+func (model *LuckyMoneyModel) isUnlocked(tx *bolt.Tx, sid string, userID int64) (bool, error) {
+	bucket, err := storage.GetBucketIfExists(tx, "luckymoney", sid, "unlocked")
+	if err != nil {
+		if err != storage.ErrNoBucket {
+			return false, err
+		}
+		return false, nil
+	}
+	return bucket.Get([]byte(strconv.FormatInt(userID, 10))) != nil, nil
+}
+
 // 创建领取记录
-func (model *LuckyMoneyModel) insertHistory(tx *bolt.Tx, sid string, luckyMoneyArr []*big.Float) (int, int, error) {
+func (model *LuckyMoneyModel) insertHistory(tx *bolt.Tx, sid string, luckyMoneyArr []*fmath.Amount) (int, int, error) {
 
 	worstSeq, bestSeq := 0, 0
-	minValue, maxValue := big.NewFloat(math.MaxFloat64), big.NewFloat(0)
+	var minValue *fmath.Amount
+	maxValue := fmath.Zero()
 	bucket, err := storage.EnsureBucketExists(tx, "luckymoney", sid, "history")
 	if err != nil {
 		return 0, 0, err
@@ -193,7 +329,7 @@ func (model *LuckyMoneyModel) insertHistory(tx *bolt.Tx, sid string, luckyMoneyA
 			return 0, 0, err
 		}
 
-		if luckyMoneyArr[i].Cmp(minValue) == -1 {
+		if minValue == nil || luckyMoneyArr[i].Cmp(minValue) == -1 {
 			minValue = luckyMoneyArr[i]
 			worstSeq = int(seq)
 		}
@@ -207,7 +343,7 @@ func (model *LuckyMoneyModel) insertHistory(tx *bolt.Tx, sid string, luckyMoneyA
 }
 
 // 领取红包
-func (model *LuckyMoneyModel) receiveLuckyMoney(tx *bolt.Tx, sid string, seq int, user *LuckyMoneyUser) (*big.Float, error) {
+func (model *LuckyMoneyModel) receiveLuckyMoney(tx *bolt.Tx, sid string, seq int, user *LuckyMoneyUser) (*fmath.Amount, error) {
 
 	bucket, err := storage.GetBucketIfExists(tx, "luckymoney", sid, "history")
 	if err != nil {
@@ -220,7 +356,6 @@ func (model *LuckyMoneyModel) receiveLuckyMoney(tx *bolt.Tx, sid string, seq int
 	if err = json.Unmarshal(jsb, &history); err != nil {
 		return nil, err
 	}
-	history.Normalization()
 	history.User = user
 
 	jsb, err = json.Marshal(&history)
@@ -234,8 +369,46 @@ func (model *LuckyMoneyModel) receiveLuckyMoney(tx *bolt.Tx, sid string, seq int
 	return history.Value, nil
 }
 
+// 是否专属用户
+func (model *LuckyMoneyModel) isRecipient(base *LuckyMoney, user *LuckyMoneyUser) bool {
+	if base.RecipientID != 0 {
+		return base.RecipientID == user.UserID
+	}
+	if len(base.RecipientName) > 0 {
+		return strings.EqualFold(base.RecipientName, user.UserName)
+	}
+	return true
+}
+
+// 检查领取范围, 首发聊天红包只有首发群组的成员才能领取
+func (model *LuckyMoneyModel) checkScope(tx *bolt.Tx, base *LuckyMoney, userID int64, chatInstance string) error {
+	if base.Scope != ScopeFirstChat && base.Scope != ScopeWhitelist {
+		return nil
+	}
+
+	// 兼容旧数据, 旧红包绑定了首次领取的聊天
+	if base.Scope == ScopeFirstChat && base.ChatID == 0 && len(base.ChatInstance) > 0 {
+		if base.ChatInstance != chatInstance {
+			return ErrOutOfScope
+		}
+		return nil
+	}
+
+	memberModel := ChatMemberModel{}
+	for _, chatID := range base.ScopeChats() {
+		member, err := memberModel.isMember(tx, chatID, userID)
+		if err != nil {
+			return err
+		}
+		if member {
+			return nil
+		}
+	}
+	return ErrOutOfScope
+}
+
 // NewLuckyMoney 创建新红包
-func (model *LuckyMoneyModel) NewLuckyMoney(data *LuckyMoney, luckyMoneyArr []*big.Float) (*LuckyMoney, error) {
+func (model *LuckyMoneyModel) NewLuckyMoney(data *LuckyMoney, luckyMoneyArr []*fmath.Amount) (*LuckyMoney, error) {
 	err := storage.DB.Update(func(tx *bolt.Tx) error {
 		// 生成红包ID
 		rootBucket, err := storage.EnsureBucketExists(tx, "luckymoney")
@@ -252,6 +425,21 @@ func (model *LuckyMoneyModel) NewLuckyMoney(data *LuckyMoney, luckyMoneyArr []*b
 			return err
 		}
 
+		// 锁定发送者资金
+		amount := fmath.Zero()
+		for _, value := range luckyMoneyArr {
+			amount.Add(amount, value)
+		}
+		accountModel := AccountModel{}
+		_, err = accountModel.lockAccount(tx, data.SenderID, data.Asset, amount, &Version{
+			Locked:          amount,
+			Reason:          ReasonGive,
+			RefLuckyMoneyID: &data.ID,
+		})
+		if err != nil {
+			return err
+		}
+
 		// 生成序列号
 		sn, err := model.generateSN(tx, data.ID)
 		if err != nil {
@@ -260,8 +448,10 @@ func (model *LuckyMoneyModel) NewLuckyMoney(data *LuckyMoney, luckyMoneyArr []*b
 		data.SN = sn
 
 		// 序列化数据
-		data.Received = big.NewFloat(0)
+		data.Received = fmath.Zero()
 		data.Active = false
+		data.ChatInstance = ""
+		data.ChatID = 0
 		jsb, err := json.Marshal(data)
 		if err != nil {
 			return err
@@ -284,6 +474,15 @@ func (model *LuckyMoneyModel) NewLuckyMoney(data *LuckyMoney, luckyMoneyArr []*b
 			return err
 		}
 
+		// 插入口令索引, 首发群组在红包发出后添加
+		if len(data.Password) > 0 {
+			for _, chatID := range data.passwordChats() {
+				if err = model.addPasswordIndex(tx, chatID, data.Password, sid); err != nil {
+					return err
+				}
+			}
+		}
+
 		// 插入领取记录
 		worstSeq, bestSeq, err := model.insertHistory(tx, sid, luckyMoneyArr)
 		if err != nil {
@@ -343,27 +542,80 @@ func (model *LuckyMoneyModel) IsExpired(id uint64) bool {
 }
 
 // SetExpired 设置过期
-func (model *LuckyMoneyModel) SetExpired(id uint64) error {
-	// 获取红包信息
-	luckyMoney, _, err := model.GetLuckyMoney(id)
-	if err != nil {
-		return nil
-	}
-
+func (model *LuckyMoneyModel) SetExpired(id uint64) (*Version, error) {
+	var version *Version
 	sid := strconv.FormatUint(id, 10)
-	return storage.DB.Update(func(tx *bolt.Tx) error {
+	err := storage.DB.Update(func(tx *bolt.Tx) error {
+		bucket, err := storage.GetBucketIfExists(tx, "luckymoney", sid)
+		if err != nil {
+			return err
+		}
+
+		// 检查状态
+		if bucket.Get([]byte("expired")) != nil {
+			return ErrLuckyMoneydExpired
+		}
+
+		// 获取红包信息
+		var base LuckyMoney
+		jsb := bucket.Get([]byte("base"))
+		if err = json.Unmarshal(jsb, &base); err != nil {
+			return err
+		}
+
+		// 已领取数量
+		seq := bucket.Get([]byte("seq"))
+		numReceived, err := strconv.Atoi(string(seq))
+		if err != nil {
+			return err
+		}
+
 		// 添加用户历史
-		if err = model.moveToUserHistory(tx, luckyMoney.SenderID, sid); err != nil {
+		if err = model.moveToUserHistory(tx, base.SenderID, sid); err != nil {
+			return err
+		}
+
+		// 删除口令索引
+		if err = model.removePasswordIndex(tx, &base, sid); err != nil {
 			return err
 		}
 
 		// 标记红包过期
-		bucket, err := storage.GetBucketIfExists(tx, "luckymoney", sid)
-		if err != nil {
+		if err = bucket.Put([]byte("expired"), []byte("true")); err != nil {
+			return err
+		}
+
+		// 是否领完了
+		if uint32(numReceived) >= base.Number {
+			return nil
+		}
+
+		// 未领完的红包计为过期
+		statsModel := StatsModel{}
+		if err = statsModel.addPacketExpired(tx, time.Now().UTC().Unix()); err != nil {
 			return err
 		}
-		return bucket.Put([]byte("expired"), []byte("true"))
+
+		// 返还红包余额
+		balance := fmath.Sub(base.Amount, base.Received)
+		if !base.Lucky {
+			amount := fmath.Mul(base.Amount, int64(base.Number))
+			balance.Sub(amount, base.Received)
+		}
+		version = &Version{
+			Locked:          fmath.Neg(balance),
+			Reason:          ReasonGiveBack,
+			RefLuckyMoneyID: &base.ID,
+		}
+		accountModel := AccountModel{}
+		_, err = accountModel.unlockAccount(tx, base.SenderID, base.Asset, balance, version)
+		return err
 	})
+
+	if err != nil {
+		return nil, err
+	}
+	return version, nil
 }
 
 // IsReceived 是否已领取
@@ -439,7 +691,6 @@ func (model *LuckyMoneyModel) GetLuckyMoney(id uint64) (*LuckyMoney, uint32, err
 		if err = json.Unmarshal(jsb, &base); err != nil {
 			return err
 		}
-		base.Normalization()
 
 		// 已领取数量
 		seq := bucket.Get([]byte("seq"))
@@ -486,84 +737,166 @@ func (model *LuckyMoneyModel) GetLuckyMoneyIDBySN(sn string) (uint64, error) {
 	return id, nil
 }
 
-// ReceiveLuckyMoney 领取红包
-func (model *LuckyMoneyModel) ReceiveLuckyMoney(id uint64, userID int64, firstName string) (*big.Float, int, error) {
-	received, err := model.IsReceived(id, userID)
+// 检查领取条件, 返回红包信息和已领取数量
+func (model *LuckyMoneyModel) checkReceive(tx *bolt.Tx, sid string, user *LuckyMoneyUser,
+	chatInstance string) (*LuckyMoney, int, error) {
+
+	bucket, err := storage.GetBucketIfExists(tx, "luckymoney", sid)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	if received {
+	// 检查状态
+	if bucket.Get([]byte("expired")) != nil {
+		return nil, 0, ErrLuckyMoneydExpired
+	}
+
+	// 已领取数量
+	seq := bucket.Get([]byte("seq"))
+	numReceived, err := strconv.Atoi(string(seq))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// 红包是否充足
+	var base LuckyMoney
+	jsb := bucket.Get([]byte("base"))
+	if err = json.Unmarshal(jsb, &base); err != nil {
+		return nil, 0, err
+	}
+
+	if uint32(numReceived) >= base.Number {
+		return nil, 0, ErrNothingLeft
+	}
+
+	// 检查开抢时间
+	if base.ActivateAt > time.Now().UTC().Unix() {
+		return nil, 0, ErrNotStarted
+	}
+
+	// 检查专属用户
+	if !model.isRecipient(&base, user) {
+		return nil, 0, ErrPermissionDenied
+	}
+
+	// 检查领取范围
+	if err = model.checkScope(tx, &base, user.UserID, chatInstance); err != nil {
+		return nil, 0, err
+	}
+
+	// 检查红包口令
+	if len(base.Password) > 0 {
+		unlocked, err := model.isUnlocked(tx, sid, user.UserID)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !unlocked {
+			return nil, 0, ErrPasswordRequired
+		}
+	}
+
+	// 是否重复领取
+	usersBucket, err := storage.GetBucketIfExists(tx, "luckymoney", sid, "users")
+	if err != nil {
+		return nil, 0, err
+	}
+	if usersBucket.Get([]byte(strconv.FormatInt(user.UserID, 10))) != nil {
 		return nil, 0, ErrRepeatReceive
 	}
+	return &base, numReceived, nil
+}
+
+// CheckReceive 检查领取条件, 不修改数据, 返回的错误与 ReceiveLuckyMoney 相同
+func (model *LuckyMoneyModel) CheckReceive(id uint64, user *LuckyMoneyUser, chatInstance string) error {
+	sid := strconv.FormatUint(id, 10)
+	return storage.DB.View(func(tx *bolt.Tx) error {
+		_, _, err := model.checkReceive(tx, sid, user, chatInstance)
+		return err
+	})
+}
+
+// ReceiveLuckyMoney 领取红包, chatInstance 为红包消息所在聊天的全局标识
+// bonus 不为空时在同一事务中向领取者发放额外奖励, 返回奖励版本
+func (model *LuckyMoneyModel) ReceiveLuckyMoney(id uint64, user *LuckyMoneyUser,
+	chatInstance string, bonus *fmath.Amount) (*fmath.Amount, int, *Version, error) {
+
+	userID := user.UserID
+
+	received, err := model.IsReceived(id, userID)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	if received {
+		return nil, 0, nil, ErrRepeatReceive
+	}
 
 	count := 0
-	value := big.NewFloat(0)
+	value := fmath.Zero()
+	var bonusVersion *Version
 	sid := strconv.FormatUint(id, 10)
 	err = storage.DB.Update(func(tx *bolt.Tx) error {
-		bucket, err := storage.GetBucketIfExists(tx, "luckymoney", sid)
+		// 检查领取条件
+		base, numReceived, err := model.checkReceive(tx, sid, user, chatInstance)
 		if err != nil {
 			return err
 		}
 
-		// 检查状态
-		if bucket.Get([]byte("expired")) != nil {
-			return ErrLuckyMoneydExpired
+		// 红包是否激活
+		if !base.Active {
+			base.Active = true
 		}
 
-		// 已领取数量
-		seq := bucket.Get([]byte("seq"))
-		numReceived, err := strconv.Atoi(string(seq))
+		// 执行领取红包
+		newSeq := numReceived + 1
+		value, err = model.receiveLuckyMoney(tx, sid, newSeq, user)
 		if err != nil {
 			return err
 		}
+		base.Received = fmath.Add(base.Received, value)
 
-		// 红包是否充足
-		var base LuckyMoney
-		jsb := bucket.Get([]byte("base"))
-		if err = json.Unmarshal(jsb, &base); err != nil {
+		// 转移锁定资产
+		accountModel := AccountModel{}
+		_, _, err = accountModel.transferFromLockAccount(tx, base.SenderID, userID, base.Asset, value, &Version{
+			Balance:         value,
+			Reason:          ReasonReceive,
+			RefLuckyMoneyID: &base.ID,
+			RefUserID:       &base.SenderID,
+			RefUserName:     &base.SenderName,
+		})
+		if err != nil {
 			return err
 		}
-		base.Normalization()
-
-		if uint32(numReceived) >= base.Number {
-			return ErrNothingLeft
-		}
 
-		// 红包是否激活
-		if !base.Active {
-			base.Active = true
+		// 发放额外奖励
+		if bonus != nil {
+			bonusVersion = &Version{
+				Balance:         bonus,
+				Reason:          ReasonSystem,
+				RefLuckyMoneyID: &base.ID,
+			}
+			if _, err = accountModel.depositAccount(tx, userID, base.Asset, bonus, bonusVersion); err != nil {
+				return err
+			}
 		}
 
-		// 是否重复领取
-		usersBucket, err := storage.GetBucketIfExists(tx, "luckymoney", sid, "users")
+		// 更新红包信息
+		bucket, err := storage.GetBucketIfExists(tx, "luckymoney", sid)
 		if err != nil {
 			return err
 		}
-		key := []byte(strconv.FormatInt(userID, 10))
-		if usersBucket.Get(key) != nil {
-			return ErrRepeatReceive
-		}
-
-		// 执行领取红包
-		newSeq := numReceived + 1
-		value, err = model.receiveLuckyMoney(tx, sid, newSeq, &LuckyMoneyUser{
-			UserID:    userID,
-			FirstName: firstName,
-		})
+		jsb, err := json.Marshal(base)
 		if err != nil {
 			return err
 		}
-		base.Received = fmath.Add(base.Received, value)
-
-		// 更新红包信息
-		if jsb, err = json.Marshal(&base); err != nil {
+		if err = bucket.Put([]byte("base"), jsb); err != nil {
 			return err
 		}
-		if err = bucket.Put([]byte("base"), jsb); err != nil {
+		usersBucket, err := storage.GetBucketIfExists(tx, "luckymoney", sid, "users")
+		if err != nil {
 			return err
 		}
-		if err = usersBucket.Put(key, []byte("")); err != nil {
+		if err = usersBucket.Put([]byte(strconv.FormatInt(userID, 10)), []byte("")); err != nil {
 			return err
 		}
 		if err = bucket.Put([]byte("seq"), []byte(strconv.Itoa(newSeq))); err != nil {
@@ -575,6 +908,9 @@ func (model *LuckyMoneyModel) ReceiveLuckyMoney(id uint64, userID int64, firstNa
 			if err = model.moveToUserHistory(tx, base.SenderID, sid); err != nil {
 				return err
 			}
+			if err = model.removePasswordIndex(tx, base, sid); err != nil {
+				return err
+			}
 		}
 
 		count = int(base.Number - uint32(newSeq))
@@ -582,9 +918,227 @@ func (model *LuckyMoneyModel) ReceiveLuckyMoney(id uint64, userID int64, firstNa
 	})
 
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, nil, err
 	}
-	return value, count, nil
+	return value, count, bonusVersion, nil
+}
+
+// BindChat 绑定首发群组, 只有发送者发出的红包消息才能绑定, 返回是否绑定
+func (model *LuckyMoneyModel) BindChat(id uint64, senderID, chatID int64) (bool, error) {
+	bound := false
+	sid := strconv.FormatUint(id, 10)
+	err := storage.DB.Update(func(tx *bolt.Tx) error {
+		bucket, err := storage.GetBucketIfExists(tx, "luckymoney", sid)
+		if err != nil {
+			if err != storage.ErrNoBucket {
+				return err
+			}
+			return nil
+		}
+
+		// 检查状态
+		if bucket.Get([]byte("expired")) != nil {
+			return nil
+		}
+		var base LuckyMoney
+		if err = json.Unmarshal(bucket.Get([]byte("base")), &base); err != nil {
+			return err
+		}
+		if base.SenderID != senderID || base.ChatID != 0 {
+			return nil
+		}
+
+		// 更新首发群组
+		base.ChatID = chatID
+		jsb, err := json.Marshal(&base)
+		if err != nil {
+			return err
+		}
+		if err = bucket.Put([]byte("base"), jsb); err != nil {
+			return err
+		}
+
+		// 插入口令索引
+		if len(base.Password) > 0 && !base.inChats(chatID) {
+			if err = model.addPasswordIndex(tx, chatID, base.Password, sid); err != nil {
+				return err
+			}
+		}
+		bound = true
+		return nil
+	})
+
+	if err != nil {
+		return false, err
+	}
+	return bound, nil
+}
+
+// SetActivateAt 设置开抢时间, 只能在无人领取前设置
+func (model *LuckyMoneyModel) SetActivateAt(id uint64, senderID int64, activateAt int64) (*LuckyMoney, error) {
+	var base LuckyMoney
+	sid := strconv.FormatUint(id, 10)
+	err := storage.DB.Update(func(tx *bolt.Tx) error {
+		bucket, err := storage.GetBucketIfExists(tx, "luckymoney", sid)
+		if err != nil {
+			return err
+		}
+
+		// 检查状态
+		if bucket.Get([]byte("expired")) != nil {
+			return ErrLuckyMoneydExpired
+		}
+		if err = json.Unmarshal(bucket.Get([]byte("base")), &base); err != nil {
+			return err
+		}
+		if base.SenderID != senderID {
+			return ErrPermissionDenied
+		}
+		if base.Active {
+			return ErrAlreadyActivated
+		}
+
+		// 更新开抢时间, 有效期从开抢时间起算
+		if base.ExpireAt > 0 {
+			base.ExpireAt += activateAt - base.StartTime()
+		}
+		base.ActivateAt = activateAt
+		jsb, err := json.Marshal(&base)
+		if err != nil {
+			return err
+		}
+		return bucket.Put([]byte("base"), jsb)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return &base, nil
+}
+
+// AddInlineMessage 记录开抢前的内联消息
+func (model *LuckyMoneyModel) AddInlineMessage(id uint64, inlineMessageID string) error {
+	sid := strconv.FormatUint(id, 10)
+	return storage.DB.Batch(func(tx *bolt.Tx) error {
+		if _, err := storage.GetBucketIfExists(tx, "luckymoney", sid); err != nil {
+			return err
+		}
+		bucket, err := storage.EnsureBucketExists(tx, "luckymoney", sid, "messages")
+		if err != nil {
+			return err
+		}
+		return bucket.Put([]byte(inlineMessageID), []byte(""))
+	})
+}
+
+// PopInlineMessages 取出开抢前的内联消息
+func (model *LuckyMoneyModel) PopInlineMessages(id uint64) ([]string, error) {
+	messages := make([]string, 0)
+	sid := strconv.FormatUint(id, 10)
+	err := storage.DB.Update(func(tx *bolt.Tx) error {
+		bucket, err := storage.GetBucketIfExists(tx, "luckymoney", sid)
+		if err != nil {
+			return err
+		}
+		messagesBucket := bucket.Bucket([]byte("messages"))
+		if messagesBucket == nil {
+			return nil
+		}
+
+		err = messagesBucket.ForEach(func(k, v []byte) error {
+			messages = append(messages, string(k))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		return bucket.DeleteBucket([]byte("messages"))
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// UnlockByPassword 根据群组中发送的口令验证红包, 返回匹配的红包ID
+func (model *LuckyMoneyModel) UnlockByPassword(chatID, userID int64, text string) ([]uint64, error) {
+	password := NormalizePassword(text)
+	if len(password) == 0 {
+		return nil, nil
+	}
+
+	// 查找口令索引
+	sids := make([]string, 0)
+	err := storage.DB.View(func(tx *bolt.Tx) error {
+		bucket, err := storage.GetBucketIfExists(tx, "luckymoney", "passwords",
+			strconv.FormatInt(chatID, 10), password)
+		if err != nil {
+			if err != storage.ErrNoBucket {
+				return err
+			}
+			return nil
+		}
+		return bucket.ForEach(func(k, v []byte) error {
+			sids = append(sids, string(k))
+			return nil
+		})
+	})
+	if err != nil || len(sids) == 0 {
+		return nil, err
+	}
+
+	// 匹配后才写入, 普通群组消息只读取索引
+	ids := make([]uint64, 0, len(sids))
+	err = storage.DB.Update(func(tx *bolt.Tx) error {
+		for _, sid := range sids {
+			id, err := strconv.ParseUint(sid, 10, 64)
+			if err != nil {
+				continue
+			}
+			if err = model.unlock(tx, sid, userID); err != nil {
+				return err
+			}
+			ids = append(ids, id)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// UnlockLuckyMoney 回答红包问题
+func (model *LuckyMoneyModel) UnlockLuckyMoney(id uint64, userID int64, answer string) error {
+	sid := strconv.FormatUint(id, 10)
+	return storage.DB.Update(func(tx *bolt.Tx) error {
+		bucket, err := storage.GetBucketIfExists(tx, "luckymoney", sid)
+		if err != nil {
+			return err
+		}
+
+		// 检查状态
+		if bucket.Get([]byte("expired")) != nil {
+			return ErrLuckyMoneydExpired
+		}
+
+		// 获取红包信息
+		var base LuckyMoney
+		jsb := bucket.Get([]byte("base"))
+		if err = json.Unmarshal(jsb, &base); err != nil {
+			return err
+		}
+
+		// 检查红包口令
+		if len(base.Password) == 0 {
+			return nil
+		}
+		if NormalizePassword(answer) != base.Password {
+			return ErrWrongPassword
+		}
+		return model.unlock(tx, sid, userID)
+	})
 }
 
 // GetReceiveHistory 获取领取历史
@@ -607,7 +1161,6 @@ func (model *LuckyMoneyModel) GetReceiveHistory(id uint64) ([]*LuckyMoneyHistory
 				if err = json.Unmarshal(v, &item); err != nil {
 					return err
 				}
-				item.Normalization()
 
 				if item.User == nil {
 					return nil
@@ -661,8 +1214,6 @@ func (model *LuckyMoneyModel) GetBestAndWorst(id uint64) (*LuckyMoneyHistory, *L
 			return err
 		}
 
-		best.Normalization()
-		worst.Normalization()
 		return nil
 	})
 
@@ -674,7 +1225,6 @@ func (model *LuckyMoneyModel) GetBestAndWorst(id uint64) (*LuckyMoneyHistory, *L
 
 // Foreach 遍历红包列表
 func (model *LuckyMoneyModel) Foreach(startID uint64, callback func(*LuckyMoney)) error {
-	var base LuckyMoney
 	return storage.DB.View(func(tx *bolt.Tx) error {
 		rootBucket, err := storage.GetBucketIfExists(tx, "luckymoney")
 		if err != nil {
@@ -685,11 +1235,11 @@ func (model *LuckyMoneyModel) Foreach(startID uint64, callback func(*LuckyMoney)
 		seek := []byte(strconv.FormatUint(startID, 10))
 		for k, v := cursor.Seek(seek); k != nil && v == nil; k, v = cursor.Next() {
 			if bucket := rootBucket.Bucket(k); bucket != nil {
+				var base LuckyMoney
 				jsb := bucket.Get([]byte("base"))
 				if err = json.Unmarshal(jsb, &base); err != nil {
 					continue
 				}
-				base.Normalization()
 
 				if callback != nil {
 					callback(&base)
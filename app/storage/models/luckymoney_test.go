@@ -0,0 +1,224 @@
+package models
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/boltdb/bolt"
+	"luckybot/app/fmath"
+	"luckybot/app/storage"
+)
+
+// 打开测试数据库
+func openTestDB(t *testing.T) {
+	t.Helper()
+	if err := storage.Connect(filepath.Join(t.TempDir(), "test.db")); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = storage.Close()
+	})
+}
+
+// 创建测试红包, 发送者余额不足时先充值
+func newTestLuckyMoney(t *testing.T, data *LuckyMoney, values ...int64) *LuckyMoney {
+	t.Helper()
+	amount := fmath.Zero()
+	shares := make([]*fmath.Amount, 0, len(values))
+	for _, value := range values {
+		shares = append(shares, fmath.NewAmount(value))
+		amount.Add(amount, fmath.NewAmount(value))
+	}
+
+	accountModel := AccountModel{}
+	_, _, err := accountModel.Deposit(data.SenderID, "BTC", amount, &Version{
+		Balance: amount,
+		Reason:  ReasonDeposit,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data.Asset = "BTC"
+	data.Amount = amount
+	data.Number = uint32(len(values))
+	data.Lucky = true
+	model := LuckyMoneyModel{}
+	luckyMoney, err := model.NewLuckyMoney(data, shares)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return luckyMoney
+}
+
+// 今日过期红包数
+func expiredToday(t *testing.T) int {
+	t.Helper()
+	statsModel := StatsModel{}
+	daily, err := statsModel.GetDaily(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return daily[0].PacketsExpired
+}
+
+func TestSetExpiredCountsOnlyUnclaimed(t *testing.T) {
+	openTestDB(t)
+	model := LuckyMoneyModel{}
+
+	// 领完后过期
+	full := newTestLuckyMoney(t, &LuckyMoney{SenderID: 1}, 100)
+	if _, _, _, err := model.ReceiveLuckyMoney(full.ID, &LuckyMoneyUser{UserID: 2}, "", nil); err != nil {
+		t.Fatal(err)
+	}
+	version, err := model.SetExpired(full.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != nil {
+		t.Fatal("fully claimed lucky money should not refund")
+	}
+	if n := expiredToday(t); n != 0 {
+		t.Fatalf("packets expired = %d, want 0", n)
+	}
+
+	// 部分领取后过期
+	partial := newTestLuckyMoney(t, &LuckyMoney{SenderID: 1}, 100, 200)
+	if _, _, _, err = model.ReceiveLuckyMoney(partial.ID, &LuckyMoneyUser{UserID: 2}, "", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = model.SetExpired(partial.ID); err != nil {
+		t.Fatal(err)
+	}
+	if n := expiredToday(t); n != 1 {
+		t.Fatalf("packets expired = %d, want 1", n)
+	}
+
+	// 重建统计与实时统计一致
+	err = storage.DB.Update(func(tx *bolt.Tx) error {
+		return migrateStats(tx)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := expiredToday(t); n != 1 {
+		t.Fatalf("migrated packets expired = %d, want 1", n)
+	}
+}
+
+func TestReceiveWithBonus(t *testing.T) {
+	openTestDB(t)
+	model := LuckyMoneyModel{}
+	luckyMoney := newTestLuckyMoney(t, &LuckyMoney{SenderID: 1}, 100, 200)
+
+	// 检查领取条件不修改数据
+	user := &LuckyMoneyUser{UserID: 2}
+	if err := model.CheckReceive(luckyMoney.ID, user, ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, received, err := model.GetLuckyMoney(luckyMoney.ID); err != nil || received != 0 {
+		t.Fatalf("received = %d, %v, want 0", received, err)
+	}
+
+	// 领取并发放奖励
+	value, _, version, err := model.ReceiveLuckyMoney(luckyMoney.ID, user, "", fmath.NewAmount(50))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version == nil || version.Reason != ReasonSystem || version.Balance.Cmp(fmath.NewAmount(50)) != 0 {
+		t.Fatalf("unexpected bonus version, %+v", version)
+	}
+	accountModel := AccountModel{}
+	account, err := accountModel.GetAccount(2, "BTC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := fmath.Add(value, fmath.NewAmount(50)); account.Amount.Cmp(expected) != 0 {
+		t.Fatalf("receiver amount = %s, want %s", account.Amount, expected)
+	}
+
+	// 重复领取
+	if err = model.CheckReceive(luckyMoney.ID, user, ""); err != ErrRepeatReceive {
+		t.Fatalf("expected ErrRepeatReceive, got %v", err)
+	}
+	if _, _, _, err = model.ReceiveLuckyMoney(luckyMoney.ID, user, "", fmath.NewAmount(50)); err != ErrRepeatReceive {
+		t.Fatalf("expected ErrRepeatReceive, got %v", err)
+	}
+	if account, _ = accountModel.GetAccount(2, "BTC"); account.Amount.Cmp(fmath.Add(value, fmath.NewAmount(50))) != 0 {
+		t.Fatalf("bonus granted on failed receive, amount = %s", account.Amount)
+	}
+}
+
+func TestFirstChatScope(t *testing.T) {
+	openTestDB(t)
+	model := LuckyMoneyModel{}
+	luckyMoney := newTestLuckyMoney(t, &LuckyMoney{SenderID: 1, Scope: ScopeFirstChat}, 100, 200)
+
+	// 发出前不能领取
+	user := &LuckyMoneyUser{UserID: 2}
+	if err := model.CheckReceive(luckyMoney.ID, user, "forwarded"); err != ErrOutOfScope {
+		t.Fatalf("expected ErrOutOfScope before posted, got %v", err)
+	}
+
+	// 只有发送者能绑定, 并且只能绑定一次
+	if bound, err := model.BindChat(luckyMoney.ID, 2, -100); err != nil || bound {
+		t.Fatalf("bind by other user = %v, %v, want false", bound, err)
+	}
+	if bound, err := model.BindChat(luckyMoney.ID, 1, -200); err != nil || !bound {
+		t.Fatalf("bind by sender = %v, %v, want true", bound, err)
+	}
+	if bound, err := model.BindChat(luckyMoney.ID, 1, -100); err != nil || bound {
+		t.Fatalf("rebind = %v, %v, want false", bound, err)
+	}
+
+	// 只有首发群组成员能领取
+	if err := model.CheckReceive(luckyMoney.ID, user, "forwarded"); err != ErrOutOfScope {
+		t.Fatalf("expected ErrOutOfScope for non-member, got %v", err)
+	}
+	memberModel := ChatMemberModel{}
+	if err := memberModel.AddMember(-200, 2); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := model.ReceiveLuckyMoney(luckyMoney.ID, user, "forwarded", nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUnlockByPasswordInChat(t *testing.T) {
+	openTestDB(t)
+	model := LuckyMoneyModel{}
+	luckyMoney := newTestLuckyMoney(t, &LuckyMoney{SenderID: 1, Password: "open sesame",
+		Message: "Open Sesame"}, 100, 200)
+
+	// 发出前任何群组都不能解锁
+	if ids, err := model.UnlockByPassword(-100, 2, "open  SESAME"); err != nil || len(ids) != 0 {
+		t.Fatalf("unlock before posted = %v, %v, want none", ids, err)
+	}
+
+	// 只能在首发群组中解锁
+	if _, err := model.BindChat(luckyMoney.ID, 1, -100); err != nil {
+		t.Fatal(err)
+	}
+	if ids, err := model.UnlockByPassword(-200, 2, "open sesame"); err != nil || len(ids) != 0 {
+		t.Fatalf("unlock in other chat = %v, %v, want none", ids, err)
+	}
+	user := &LuckyMoneyUser{UserID: 2}
+	if err := model.CheckReceive(luckyMoney.ID, user, ""); err != ErrPasswordRequired {
+		t.Fatalf("expected ErrPasswordRequired, got %v", err)
+	}
+	ids, err := model.UnlockByPassword(-100, 2, "open  SESAME")
+	if err != nil || len(ids) != 1 || ids[0] != luckyMoney.ID {
+		t.Fatalf("unlock in posted chat = %v, %v, want [%d]", ids, err, luckyMoney.ID)
+	}
+	if err = model.CheckReceive(luckyMoney.ID, user, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	// 过期后删除索引
+	if _, err = model.SetExpired(luckyMoney.ID); err != nil {
+		t.Fatal(err)
+	}
+	if ids, err = model.UnlockByPassword(-100, 3, "open sesame"); err != nil || len(ids) != 0 {
+		t.Fatalf("unlock after expired = %v, %v, want none", ids, err)
+	}
+}
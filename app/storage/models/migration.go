@@ -0,0 +1,370 @@
+package models
+
+import (
+	"encoding/json"
+	"math/big"
+	"strconv"
+
+	"github.com/boltdb/bolt"
+	"luckybot/app/storage"
+)
+
+// SchemaVersion 当前数据版本
+const SchemaVersion = 4
+
+// ********************** 结构图 **********************
+// {
+//	"meta": {
+// 		"schema_version": 0	// 数据版本
+//	}
+// }
+// ***************************************************
+
+// Migrate 迁移历史数据
+// 版本1: 金额由浮点数文本转换为资产最小单位整数
+// 版本2: 根据账户和账户版本建立统计数据
+// 版本3: 重建统计数据, 修正领完后过期的红包被计为过期
+// 版本4: 口令索引按群组划分
+func Migrate(precision func(symbol string) int) error {
+	return storage.DB.Update(func(tx *bolt.Tx) error {
+		bucket, err := storage.EnsureBucketExists(tx, "meta")
+		if err != nil {
+			return err
+		}
+
+		version := 0
+		if data := bucket.Get([]byte("schema_version")); data != nil {
+			if version, err = strconv.Atoi(string(data)); err != nil {
+				return err
+			}
+		}
+		if version >= SchemaVersion {
+			return nil
+		}
+
+		if version < 1 {
+			if err = migrateFixedPoint(tx, precision); err != nil {
+				return err
+			}
+		}
+		if version < 3 {
+			if err = migrateStats(tx); err != nil {
+				return err
+			}
+		}
+		if version < 4 {
+			if err = migratePasswordIndex(tx); err != nil {
+				return err
+			}
+		}
+		return bucket.Put([]byte("schema_version"), []byte(strconv.Itoa(SchemaVersion)))
+	})
+}
+
+// 转换定点金额, 遍历时不能修改桶, 先收集再写入
+func migrateFixedPoint(tx *bolt.Tx, precision func(symbol string) int) error {
+	// 账户信息
+	err := forEachUserBucket(tx, "accounts", func(bucket *bolt.Bucket) error {
+		return convertRecords(bucket, "symbol", precision, "amount", "locked")
+	})
+	if err != nil {
+		return err
+	}
+
+	// 账户版本
+	err = forEachUserBucket(tx, "account_versions", func(bucket *bolt.Bucket) error {
+		return convertRecords(bucket, "symbol", precision, "balance", "locked", "fee", "amount")
+	})
+	if err != nil {
+		return err
+	}
+
+	// 提现记录
+	if bucket := tx.Bucket([]byte("withdrawals")); bucket != nil {
+		if err = convertRecords(bucket, "symbol", precision, "amount", "fee"); err != nil {
+			return err
+		}
+	}
+
+	// 红包记录
+	root := tx.Bucket([]byte("luckymoney"))
+	if root == nil {
+		return nil
+	}
+	sids := make([][]byte, 0)
+	err = root.ForEach(func(k, v []byte) error {
+		if v != nil {
+			return nil
+		}
+		if _, err := strconv.ParseUint(string(k), 10, 64); err != nil {
+			return nil
+		}
+		sids = append(sids, append([]byte{}, k...))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, sid := range sids {
+		bucket := root.Bucket(sid)
+		base := bucket.Get([]byte("base"))
+		if base == nil {
+			continue
+		}
+		var info struct {
+			Asset string `json:"asset"`
+		}
+		if err = json.Unmarshal(base, &info); err != nil {
+			return err
+		}
+		data, err := convertRecord(base, "asset", precision, "amount", "received", "value")
+		if err != nil {
+			return err
+		}
+		if err = bucket.Put([]byte("base"), data); err != nil {
+			return err
+		}
+
+		history := bucket.Bucket([]byte("history"))
+		if history == nil {
+			continue
+		}
+		err = convertRecords(history, "", func(string) int {
+			return precision(info.Asset)
+		}, "value")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 建立统计数据, 历史过期红包按退还记录统计
+func migrateStats(tx *bolt.Tx) error {
+	if tx.Bucket([]byte("stats")) != nil {
+		if err := tx.DeleteBucket([]byte("stats")); err != nil {
+			return err
+		}
+	}
+
+	// 资产总量
+	statsModel := StatsModel{}
+	err := forEachUserRecord(tx, "accounts", func(bucket *bolt.Bucket, k, v []byte) error {
+		var account Account
+		if err := json.Unmarshal(v, &account); err != nil {
+			return err
+		}
+		return statsModel.addTotals(tx, account.Symbol, account.Amount, account.Locked)
+	})
+	if err != nil {
+		return err
+	}
+
+	// 每日统计
+	root := tx.Bucket([]byte("account_versions"))
+	if root == nil {
+		return nil
+	}
+	return root.ForEach(func(k, v []byte) error {
+		if v != nil {
+			return nil
+		}
+		userID, err := strconv.ParseInt(string(k), 10, 64)
+		if err != nil {
+			return nil
+		}
+		return root.Bucket(k).ForEach(func(k, v []byte) error {
+			var version Version
+			if err := json.Unmarshal(v, &version); err != nil {
+				return err
+			}
+			if version.Reason == ReasonGiveBack {
+				return statsModel.addPacketExpired(tx, version.Timestamp)
+			}
+			return statsModel.recordVersion(tx, userID, &version)
+		})
+	})
+}
+
+// 重建口令索引, 旧红包没有首发群组只能按群组白名单建立
+func migratePasswordIndex(tx *bolt.Tx) error {
+	root := tx.Bucket([]byte("luckymoney"))
+	if root == nil {
+		return nil
+	}
+	if root.Bucket([]byte("passwords")) != nil {
+		if err := root.DeleteBucket([]byte("passwords")); err != nil {
+			return err
+		}
+	}
+
+	// 遍历时不能修改桶, 先收集未过期的口令红包
+	packets := make(map[string]*LuckyMoney)
+	err := root.ForEach(func(k, v []byte) error {
+		if v != nil {
+			return nil
+		}
+		if _, err := strconv.ParseUint(string(k), 10, 64); err != nil {
+			return nil
+		}
+
+		bucket := root.Bucket(k)
+		if bucket.Get([]byte("expired")) != nil {
+			return nil
+		}
+		jsb := bucket.Get([]byte("base"))
+		if jsb == nil {
+			return nil
+		}
+		var base LuckyMoney
+		if err := json.Unmarshal(jsb, &base); err != nil {
+			return err
+		}
+		if len(base.Password) > 0 {
+			packets[string(k)] = &base
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	model := LuckyMoneyModel{}
+	for sid, base := range packets {
+		for _, chatID := range base.passwordChats() {
+			if err = model.addPasswordIndex(tx, chatID, base.Password, sid); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// 遍历用户记录
+func forEachUserRecord(tx *bolt.Tx, name string, handler func(*bolt.Bucket, []byte, []byte) error) error {
+	root := tx.Bucket([]byte(name))
+	if root == nil {
+		return nil
+	}
+	return root.ForEach(func(k, v []byte) error {
+		if v != nil {
+			return nil
+		}
+		bucket := root.Bucket(k)
+		return bucket.ForEach(func(k, v []byte) error {
+			return handler(bucket, k, v)
+		})
+	})
+}
+
+// 遍历用户子桶, 先收集子桶名称, 处理函数可以修改子桶
+func forEachUserBucket(tx *bolt.Tx, name string, handler func(*bolt.Bucket) error) error {
+	root := tx.Bucket([]byte(name))
+	if root == nil {
+		return nil
+	}
+	keys := make([][]byte, 0)
+	err := root.ForEach(func(k, v []byte) error {
+		if v == nil {
+			keys = append(keys, append([]byte{}, k...))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err = handler(root.Bucket(key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 转换桶中所有记录的金额字段
+func convertRecords(bucket *bolt.Bucket, symbolField string,
+	precision func(symbol string) int, fields ...string) error {
+
+	type record struct {
+		key  []byte
+		data []byte
+	}
+	records := make([]record, 0)
+	err := bucket.ForEach(func(k, v []byte) error {
+		if v == nil {
+			return nil
+		}
+		data, err := convertRecord(v, symbolField, precision, fields...)
+		if err != nil {
+			return err
+		}
+		records = append(records, record{key: append([]byte{}, k...), data: data})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, r := range records {
+		if err = bucket.Put(r.key, r.data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 转换记录中的金额字段
+func convertRecord(jsb []byte, symbolField string,
+	precision func(symbol string) int, fields ...string) ([]byte, error) {
+
+	var record map[string]json.RawMessage
+	if err := json.Unmarshal(jsb, &record); err != nil {
+		return nil, err
+	}
+
+	symbol := ""
+	if raw, ok := record[symbolField]; ok {
+		_ = json.Unmarshal(raw, &symbol)
+	}
+
+	for _, field := range fields {
+		raw, ok := record[field]
+		if !ok || string(raw) == "null" {
+			continue
+		}
+
+		var text string
+		if err := json.Unmarshal(raw, &text); err != nil {
+			text = string(raw)
+		}
+		value, err := toUnits(text, precision(symbol))
+		if err != nil {
+			return nil, err
+		}
+		if record[field], err = json.Marshal(value); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(record)
+}
+
+// 浮点数文本转换为最小单位
+func toUnits(text string, precision int) (string, error) {
+	value, _, err := big.ParseFloat(text, 10, 256, big.ToNearestEven)
+	if err != nil {
+		return "", err
+	}
+
+	wei := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(precision)), nil)
+	value.Mul(value, new(big.Float).SetPrec(256).SetInt(wei))
+
+	half := big.NewFloat(0.5)
+	if value.Sign() < 0 {
+		half.Neg(half)
+	}
+	value.Add(value, half)
+
+	units, _ := value.Int(nil)
+	return units.String(), nil
+}
@@ -0,0 +1,144 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/boltdb/bolt"
+	"luckybot/app/fmath"
+	"luckybot/app/storage"
+)
+
+// 写入浮点数文本格式的旧数据
+func putFixture(t *testing.T, data map[string]string, path ...string) {
+	t.Helper()
+	err := storage.DB.Update(func(tx *bolt.Tx) error {
+		bucket, err := storage.EnsureBucketExists(tx, path...)
+		if err != nil {
+			return err
+		}
+		for k, v := range data {
+			if err = bucket.Put([]byte(k), []byte(v)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMigrateFixedPoint(t *testing.T) {
+	openTestDB(t)
+	precision := func(symbol string) int {
+		if symbol == "ETH" {
+			return 2
+		}
+		return 8
+	}
+
+	// 旧版本金额为浮点数
+	putFixture(t, map[string]string{
+		"BTC": `{"symbol":"BTC","amount":1.5,"locked":0.00000001}`,
+		"ETH": `{"symbol":"ETH","amount":"2.005","locked":0}`,
+	}, "accounts", "1")
+	versions := make(map[string]string)
+	withdrawals := make(map[string]string)
+	for i := 1; i <= 200; i++ {
+		versions[strconv.Itoa(i)] = fmt.Sprintf(
+			`{"id":%d,"symbol":"BTC","balance":0.1,"amount":%d.1,"timestamp":1577836800,"reason":%d}`,
+			i, i, ReasonDeposit)
+		withdrawals[strconv.Itoa(i)] = fmt.Sprintf(
+			`{"id":%d,"user_id":1,"symbol":"ETH","amount":%d.25,"fee":0.01}`, i, i)
+	}
+	putFixture(t, versions, "account_versions", "1")
+	err := storage.DB.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte("account_versions")).Bucket([]byte("1")).SetSequence(200)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	putFixture(t, withdrawals, "withdrawals")
+	putFixture(t, map[string]string{
+		"seq":  "1",
+		"base": `{"id":100001,"asset":"BTC","amount":0.3,"received":0.1,"number":2,"lucky":true}`,
+	}, "luckymoney", "100001")
+	putFixture(t, map[string]string{
+		"1": `{"value":0.1,"user":{"user_id":2}}`,
+		"2": `{"value":0.2}`,
+	}, "luckymoney", "100001", "history")
+
+	if err = Migrate(precision); err != nil {
+		t.Fatal(err)
+	}
+
+	// 账户信息
+	expect := func(name string, amount *fmath.Amount, units int64) {
+		t.Helper()
+		if amount == nil || amount.Cmp(fmath.NewAmount(units)) != 0 {
+			t.Fatalf("%s = %v, want %d", name, amount, units)
+		}
+	}
+	accountModel := AccountModel{}
+	account, err := accountModel.GetAccount(1, "BTC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect("BTC amount", account.Amount, 150000000)
+	expect("BTC locked", account.Locked, 1)
+	if account, err = accountModel.GetAccount(1, "ETH"); err != nil {
+		t.Fatal(err)
+	}
+	expect("ETH amount", account.Amount, 201)
+
+	// 账户版本和提现记录全部转换
+	versionModel := AccountVersionModel{}
+	array, total, err := versionModel.GetVersions(1, 0, 200, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 200 || len(array) != 200 {
+		t.Fatalf("versions = %d/%d, want 200", len(array), total)
+	}
+	for _, version := range array {
+		expect("version balance", version.Balance, 10000000)
+		expect("version amount", version.Amount, int64(version.ID)*100000000+10000000)
+	}
+	withdrawalModel := WithdrawalModel{}
+	for i := uint64(1); i <= 200; i++ {
+		withdrawal, err := withdrawalModel.GetWithdrawal(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect("withdrawal amount", withdrawal.Amount, int64(i)*100+25)
+		expect("withdrawal fee", withdrawal.Fee, 1)
+	}
+
+	// 红包记录按红包资产精度转换
+	luckyMoneyModel := LuckyMoneyModel{}
+	luckyMoney, _, err := luckyMoneyModel.GetLuckyMoney(100001)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect("lucky money amount", luckyMoney.Amount, 30000000)
+	expect("lucky money received", luckyMoney.Received, 10000000)
+	history, err := luckyMoneyModel.GetReceiveHistory(100001)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 {
+		t.Fatalf("history = %d, want 1", len(history))
+	}
+	expect("history value", history[0].Value, 10000000)
+
+	// 再次迁移不重复转换
+	if err = Migrate(precision); err != nil {
+		t.Fatal(err)
+	}
+	if account, err = accountModel.GetAccount(1, "BTC"); err != nil {
+		t.Fatal(err)
+	}
+	expect("BTC amount after second migration", account.Amount, 150000000)
+}
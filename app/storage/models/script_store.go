@@ -0,0 +1,107 @@
+package models
+
+import (
+	"errors"
+
+	"github.com/boltdb/bolt"
+	"luckybot/app/storage"
+)
+
+// ********************** 结构图 **********************
+// {
+//	"script_store": {
+// 		<namespace>: {
+// 			<key>: <value>	// 脚本数据
+// 		}
+//	}
+// ***************************************************
+
+// ErrInvalidNamespace 无效命名空间
+var ErrInvalidNamespace = errors.New("invalid namespace")
+
+// ScriptStoreModel 脚本存储模型
+type ScriptStoreModel struct {
+}
+
+// Get 获取数据
+func (model *ScriptStoreModel) Get(namespace, key string) (string, bool, error) {
+	if len(namespace) == 0 {
+		return "", false, ErrInvalidNamespace
+	}
+
+	var value []byte
+	err := storage.DB.View(func(tx *bolt.Tx) error {
+		bucket, err := storage.GetBucketIfExists(tx, "script_store", namespace)
+		if err != nil {
+			return err
+		}
+		if data := bucket.Get([]byte(key)); data != nil {
+			value = append([]byte{}, data...)
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoBucket) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	return string(value), value != nil, nil
+}
+
+// Set 设置数据
+func (model *ScriptStoreModel) Set(namespace, key, value string) error {
+	if len(namespace) == 0 {
+		return ErrInvalidNamespace
+	}
+	return storage.DB.Update(func(tx *bolt.Tx) error {
+		bucket, err := storage.EnsureBucketExists(tx, "script_store", namespace)
+		if err != nil {
+			return err
+		}
+		return bucket.Put([]byte(key), []byte(value))
+	})
+}
+
+// Delete 删除数据
+func (model *ScriptStoreModel) Delete(namespace, key string) error {
+	if len(namespace) == 0 {
+		return ErrInvalidNamespace
+	}
+	err := storage.DB.Update(func(tx *bolt.Tx) error {
+		bucket, err := storage.GetBucketIfExists(tx, "script_store", namespace)
+		if err != nil {
+			return err
+		}
+		return bucket.Delete([]byte(key))
+	})
+	if errors.Is(err, storage.ErrNoBucket) {
+		return nil
+	}
+	return err
+}
+
+// Keys 获取所有键
+func (model *ScriptStoreModel) Keys(namespace string) ([]string, error) {
+	if len(namespace) == 0 {
+		return nil, ErrInvalidNamespace
+	}
+
+	keys := make([]string, 0)
+	err := storage.DB.View(func(tx *bolt.Tx) error {
+		bucket, err := storage.GetBucketIfExists(tx, "script_store", namespace)
+		if err != nil {
+			return err
+		}
+		return bucket.ForEach(func(k, v []byte) error {
+			if v != nil {
+				keys = append(keys, string(k))
+			}
+			return nil
+		})
+	})
+	if err != nil && !errors.Is(err, storage.ErrNoBucket) {
+		return nil, err
+	}
+	return keys, nil
+}
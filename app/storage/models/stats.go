@@ -0,0 +1,240 @@
+package models
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/boltdb/bolt"
+	"luckybot/app/fmath"
+	"luckybot/app/location"
+	"luckybot/app/storage"
+)
+
+// ********************** 结构图 **********************
+// {
+//	"stats": {
+//		"totals": {
+// 			<symbol>: AssetTotals	// 全部用户资产总量
+// 		},
+//		"daily": {
+// 			<date>: DailyStats		// 每日统计
+// 		},
+//		"active": {
+// 			<date>: {
+// 				<user_id>: ""		// 当日活跃用户
+// 			}
+// 		}
+//	}
+// }
+// ***************************************************
+
+// AssetTotals 资产总量
+type AssetTotals struct {
+	Symbol string        `json:"symbol"` // 资产符号
+	Amount *fmath.Amount `json:"amount"` // 可用余额
+	Locked *fmath.Amount `json:"locked"` // 锁定金额
+}
+
+// AssetFlow 资产流水
+type AssetFlow struct {
+	Count  int           `json:"count"`  // 笔数
+	Amount *fmath.Amount `json:"amount"` // 金额
+}
+
+// DailyStats 每日统计
+type DailyStats struct {
+	Date           string                `json:"date"`            // 日期
+	Deposits       map[string]*AssetFlow `json:"deposits"`        // 充值
+	Withdrawals    map[string]*AssetFlow `json:"withdrawals"`     // 提现成功
+	PacketsCreated int                   `json:"packets_created"` // 创建红包数
+	PacketsClaimed int                   `json:"packets_claimed"` // 领取红包数
+	PacketsExpired int                   `json:"packets_expired"` // 过期红包数
+	ActiveUsers    int                   `json:"active_users"`    // 活跃用户数
+}
+
+// 累加资产流水
+func (stats *DailyStats) addFlow(flows map[string]*AssetFlow, symbol string, amount *fmath.Amount) {
+	flow, ok := flows[symbol]
+	if !ok {
+		flow = &AssetFlow{Amount: fmath.Zero()}
+		flows[symbol] = flow
+	}
+	flow.Count++
+	flow.Amount.Add(flow.Amount, amount)
+}
+
+// StatsModel 统计模型, 统计数据在业务事务中增量更新
+type StatsModel struct {
+}
+
+// GetTotals 获取资产总量
+func (model *StatsModel) GetTotals() ([]*AssetTotals, error) {
+	totals := make([]*AssetTotals, 0)
+	err := storage.DB.View(func(tx *bolt.Tx) error {
+		bucket, err := storage.GetBucketIfExists(tx, "stats", "totals")
+		if err != nil {
+			return err
+		}
+		return bucket.ForEach(func(k, v []byte) error {
+			var item AssetTotals
+			if err := json.Unmarshal(v, &item); err != nil {
+				return err
+			}
+			totals = append(totals, &item)
+			return nil
+		})
+	})
+	if err != nil && err != storage.ErrNoBucket {
+		return nil, err
+	}
+	sort.Slice(totals, func(i, j int) bool {
+		return totals[i].Symbol < totals[j].Symbol
+	})
+	return totals, nil
+}
+
+// GetDaily 获取最近days天的每日统计, 按日期升序返回, 没有数据的日期返回空统计
+func (model *StatsModel) GetDaily(days int) ([]*DailyStats, error) {
+	now := location.Now()
+	result := make([]*DailyStats, 0, days)
+	err := storage.DB.View(func(tx *bolt.Tx) error {
+		bucket, err := storage.GetBucketIfExists(tx, "stats", "daily")
+		if err != nil && err != storage.ErrNoBucket {
+			return err
+		}
+		for i := days - 1; i >= 0; i-- {
+			date := now.AddDate(0, 0, -i).Format("2006-01-02")
+			stats, err := model.getDaily(bucket, date)
+			if err != nil {
+				return err
+			}
+			result = append(result, stats)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// 获取每日统计
+func (model *StatsModel) getDaily(bucket *bolt.Bucket, date string) (*DailyStats, error) {
+	stats := DailyStats{Date: date}
+	if bucket != nil {
+		if jsb := bucket.Get([]byte(date)); jsb != nil {
+			if err := json.Unmarshal(jsb, &stats); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if stats.Deposits == nil {
+		stats.Deposits = make(map[string]*AssetFlow)
+	}
+	if stats.Withdrawals == nil {
+		stats.Withdrawals = make(map[string]*AssetFlow)
+	}
+	return &stats, nil
+}
+
+// 更新每日统计
+func (model *StatsModel) updateDaily(tx *bolt.Tx, timestamp int64, handler func(*DailyStats)) error {
+	bucket, err := storage.EnsureBucketExists(tx, "stats", "daily")
+	if err != nil {
+		return err
+	}
+
+	date := location.Date(timestamp)
+	stats, err := model.getDaily(bucket, date)
+	if err != nil {
+		return err
+	}
+	handler(stats)
+
+	jsb, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	return bucket.Put([]byte(date), jsb)
+}
+
+// 累加资产总量
+func (model *StatsModel) addTotals(tx *bolt.Tx, symbol string, amount, locked *fmath.Amount) error {
+	bucket, err := storage.EnsureBucketExists(tx, "stats", "totals")
+	if err != nil {
+		return err
+	}
+
+	totals := AssetTotals{Symbol: symbol, Amount: fmath.Zero(), Locked: fmath.Zero()}
+	if jsb := bucket.Get([]byte(symbol)); jsb != nil {
+		if err = json.Unmarshal(jsb, &totals); err != nil {
+			return err
+		}
+	}
+	totals.Amount.Add(totals.Amount, amount)
+	totals.Locked.Add(totals.Locked, locked)
+
+	jsb, err := json.Marshal(&totals)
+	if err != nil {
+		return err
+	}
+	return bucket.Put([]byte(symbol), jsb)
+}
+
+// 记录活跃用户, 每个用户每天只计一次
+func (model *StatsModel) addActiveUser(tx *bolt.Tx, timestamp int64, userID int64) error {
+	bucket, err := storage.EnsureBucketExists(tx, "stats", "active", location.Date(timestamp))
+	if err != nil {
+		return err
+	}
+
+	key := []byte(strconv.FormatInt(userID, 10))
+	if bucket.Get(key) != nil {
+		return nil
+	}
+	if err = bucket.Put(key, []byte("")); err != nil {
+		return err
+	}
+	return model.updateDaily(tx, timestamp, func(stats *DailyStats) {
+		stats.ActiveUsers++
+	})
+}
+
+// 根据账户版本更新统计
+// 发红包、领红包、转账和提现的用户计为活跃用户
+func (model *StatsModel) recordVersion(tx *bolt.Tx, userID int64, version *Version) error {
+	switch version.Reason {
+	case ReasonGive, ReasonReceive, ReasonWithdraw, ReasonTransferOut:
+		if err := model.addActiveUser(tx, version.Timestamp, userID); err != nil {
+			return err
+		}
+	}
+
+	switch version.Reason {
+	case ReasonDeposit:
+		return model.updateDaily(tx, version.Timestamp, func(stats *DailyStats) {
+			stats.addFlow(stats.Deposits, version.Symbol, version.Balance)
+		})
+	case ReasonWithdrawSuccess:
+		return model.updateDaily(tx, version.Timestamp, func(stats *DailyStats) {
+			stats.addFlow(stats.Withdrawals, version.Symbol, fmath.Abs(version.Locked))
+		})
+	case ReasonGive:
+		return model.updateDaily(tx, version.Timestamp, func(stats *DailyStats) {
+			stats.PacketsCreated++
+		})
+	case ReasonReceive:
+		return model.updateDaily(tx, version.Timestamp, func(stats *DailyStats) {
+			stats.PacketsClaimed++
+		})
+	}
+	return nil
+}
+
+// 记录过期红包
+func (model *StatsModel) addPacketExpired(tx *bolt.Tx, timestamp int64) error {
+	return model.updateDaily(tx, timestamp, func(stats *DailyStats) {
+		stats.PacketsExpired++
+	})
+}
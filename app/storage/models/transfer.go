@@ -0,0 +1,176 @@
+package models
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"luckybot/app/fmath"
+	"luckybot/app/location"
+	"luckybot/app/storage"
+)
+
+// Transfer 转账信息
+type Transfer struct {
+	Ref      string        // 唯一引用
+	From     int64         // 付款用户
+	FromName string        // 付款用户名
+	To       int64         // 收款用户
+	ToName   string        // 收款用户名
+	Symbol   string        // 货币符号
+	Amount   *fmath.Amount // 转账金额
+}
+
+var (
+	// ErrTransferProcessed 转账已处理
+	ErrTransferProcessed = errors.New("transfer processed")
+	// ErrTransferLimitExceeded 超出每日转账限额
+	ErrTransferLimitExceeded = errors.New("transfer daily limit exceeded")
+)
+
+// ********************** 结构图 **********************
+// {
+//	"transfers": {
+//		"refs": {
+// 			<ref>: <user_id>		// 已处理转账
+// 		},
+//		"limits": {
+// 			<user_id>: {
+// 				<date>/<symbol>: Amount	// 当日已转金额
+// 			}
+// 		}
+//	}
+// }
+// ***************************************************
+
+// TransferModel 转账模型
+type TransferModel struct {
+}
+
+// Transfer 执行转账, 限额为零表示不限制
+func (model *TransferModel) Transfer(transfer *Transfer, dailyLimit *fmath.Amount) (*Account, error) {
+	var fromAccount *Account
+	err := storage.DB.Update(func(tx *bolt.Tx) error {
+		// 检查是否重复
+		refs, err := storage.EnsureBucketExists(tx, "transfers", "refs")
+		if err != nil {
+			return err
+		}
+		if refs.Get([]byte(transfer.Ref)) != nil {
+			return ErrTransferProcessed
+		}
+
+		// 检查每日限额
+		used, err := model.addDailyUsed(tx, transfer.From, transfer.Symbol, transfer.Amount)
+		if err != nil {
+			return err
+		}
+		if dailyLimit != nil && dailyLimit.Sign() > 0 && used.Cmp(dailyLimit) == 1 {
+			return ErrTransferLimitExceeded
+		}
+
+		// 扣除付款资产
+		accountModel := AccountModel{}
+		fromAccount, err = accountModel.update(tx, transfer.From, transfer.Symbol, false,
+			func(account *Account) error {
+				if transfer.Amount.Cmp(account.Amount) == 1 {
+					return ErrInsufficientAmount
+				}
+				account.Amount.Sub(account.Amount, transfer.Amount)
+				return nil
+			})
+		if err != nil {
+			return err
+		}
+		err = accountModel.appendVersion(tx, transfer.From, fromAccount, &Version{
+			Balance:     fmath.Neg(transfer.Amount),
+			Reason:      ReasonTransferOut,
+			RefUserID:   &transfer.To,
+			RefUserName: &transfer.ToName,
+		})
+		if err != nil {
+			return err
+		}
+
+		// 增加收款资产
+		toAccount, err := accountModel.update(tx, transfer.To, transfer.Symbol, true,
+			func(account *Account) error {
+				account.Amount.Add(account.Amount, transfer.Amount)
+				return nil
+			})
+		if err != nil {
+			return err
+		}
+		err = accountModel.appendVersion(tx, transfer.To, toAccount, &Version{
+			Balance:     fmath.Zero().Set(transfer.Amount),
+			Reason:      ReasonTransferIn,
+			RefUserID:   &transfer.From,
+			RefUserName: &transfer.FromName,
+		})
+		if err != nil {
+			return err
+		}
+		return refs.Put([]byte(transfer.Ref), []byte(strconv.FormatInt(transfer.From, 10)))
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return fromAccount, nil
+}
+
+// GetDailyUsed 获取当日已转金额
+func (model *TransferModel) GetDailyUsed(userID int64, symbol string) (*fmath.Amount, error) {
+	used := fmath.Zero()
+	err := storage.DB.View(func(tx *bolt.Tx) error {
+		bucket, err := storage.GetBucketIfExists(tx, "transfers", "limits", strconv.FormatInt(userID, 10))
+		if err != nil {
+			if err != storage.ErrNoBucket {
+				return err
+			}
+			return nil
+		}
+
+		value := bucket.Get(model.dailyKey(symbol))
+		if value == nil {
+			return nil
+		}
+		return used.UnmarshalText(value)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return used, nil
+}
+
+// 累加当日已转金额
+func (model *TransferModel) addDailyUsed(tx *bolt.Tx, userID int64, symbol string,
+	amount *fmath.Amount) (*fmath.Amount, error) {
+
+	bucket, err := storage.EnsureBucketExists(tx, "transfers", "limits", strconv.FormatInt(userID, 10))
+	if err != nil {
+		return nil, err
+	}
+
+	used := fmath.Zero()
+	key := model.dailyKey(symbol)
+	if value := bucket.Get(key); value != nil {
+		if err = used.UnmarshalText(value); err != nil {
+			return nil, err
+		}
+	}
+	used.Add(used, amount)
+
+	jsb, err := used.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return used, bucket.Put(key, jsb)
+}
+
+// 生成当日限额键
+func (model *TransferModel) dailyKey(symbol string) []byte {
+	return []byte(location.Date(time.Now().UTC().Unix()) + "/" + symbol)
+}
@@ -0,0 +1,182 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/boltdb/bolt"
+	"luckybot/app/storage"
+)
+
+// User 用户设置
+type User struct {
+	Language  string `json:"language"`             // 语言代码
+	FirstName string `json:"first_name,omitempty"` // 用户昵称
+	UserName  string `json:"user_name,omitempty"`  // 用户名
+}
+
+var (
+	// ErrNoSuchUser 没有此用户
+	ErrNoSuchUser = errors.New("no such user")
+)
+
+// ********************** 结构图 **********************
+// {
+//	"users": {
+// 		<user_id>: User	// 用户设置
+//	},
+//	"usernames": {
+// 		<user_name>: <user_id>	// 用户名索引
+//	}
+// }
+// ***************************************************
+
+// UserModel 用户模型
+type UserModel struct {
+}
+
+// GetUser 获取用户设置
+func (model *UserModel) GetUser(userID int64) (*User, error) {
+	var user User
+	key := strconv.FormatInt(userID, 10)
+	err := storage.DB.View(func(tx *bolt.Tx) error {
+		bucket, err := storage.GetBucketIfExists(tx, "users")
+		if err != nil {
+			return err
+		}
+
+		jsb := bucket.Get([]byte(key))
+		if jsb == nil {
+			return ErrNoSuchUser
+		}
+		return json.Unmarshal(jsb, &user)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// GetLanguage 获取用户语言
+func (model *UserModel) GetLanguage(userID int64) string {
+	user, err := model.GetUser(userID)
+	if err != nil {
+		return ""
+	}
+	return user.Language
+}
+
+// SetLanguage 设置用户语言
+func (model *UserModel) SetLanguage(userID int64, language string) error {
+	return model.update(userID, func(user *User) {
+		user.Language = language
+	})
+}
+
+// InitLanguage 初始化用户语言, 已设置时忽略
+func (model *UserModel) InitLanguage(userID int64, language string) error {
+	if len(model.GetLanguage(userID)) > 0 {
+		return nil
+	}
+	return model.update(userID, func(user *User) {
+		if len(user.Language) == 0 {
+			user.Language = language
+		}
+	})
+}
+
+// GetUserIDByName 根据用户名获取用户ID
+func (model *UserModel) GetUserIDByName(userName string) (int64, error) {
+	var userID int64
+	key := strings.ToLower(strings.TrimPrefix(userName, "@"))
+	err := storage.DB.View(func(tx *bolt.Tx) error {
+		bucket, err := storage.GetBucketIfExists(tx, "usernames")
+		if err != nil {
+			if err != storage.ErrNoBucket {
+				return err
+			}
+			return ErrNoSuchUser
+		}
+
+		value := bucket.Get([]byte(key))
+		if value == nil {
+			return ErrNoSuchUser
+		}
+		userID, err = strconv.ParseInt(string(value), 10, 64)
+		return err
+	})
+
+	if err != nil {
+		return 0, err
+	}
+	return userID, nil
+}
+
+// UpdateProfile 更新用户资料
+func (model *UserModel) UpdateProfile(userID int64, firstName, userName string) error {
+	user, err := model.GetUser(userID)
+	if err == nil && user.FirstName == firstName && user.UserName == userName {
+		return nil
+	}
+
+	return storage.DB.Update(func(tx *bolt.Tx) error {
+		bucket, err := storage.EnsureBucketExists(tx, "usernames")
+		if err != nil {
+			return err
+		}
+
+		var oldName string
+		err = model.updateUser(tx, userID, func(user *User) {
+			oldName = user.UserName
+			user.FirstName = firstName
+			user.UserName = userName
+		})
+		if err != nil {
+			return err
+		}
+
+		// 更新用户名索引
+		if len(oldName) > 0 && !strings.EqualFold(oldName, userName) {
+			if err = bucket.Delete([]byte(strings.ToLower(oldName))); err != nil {
+				return err
+			}
+		}
+		if len(userName) == 0 {
+			return nil
+		}
+		return bucket.Put([]byte(strings.ToLower(userName)), []byte(strconv.FormatInt(userID, 10)))
+	})
+}
+
+// 更新用户设置
+func (model *UserModel) update(userID int64, handler func(*User)) error {
+	return storage.DB.Update(func(tx *bolt.Tx) error {
+		return model.updateUser(tx, userID, handler)
+	})
+}
+
+// 更新用户信息
+func (model *UserModel) updateUser(tx *bolt.Tx, userID int64, handler func(*User)) error {
+	key := []byte(strconv.FormatInt(userID, 10))
+	bucket, err := storage.EnsureBucketExists(tx, "users")
+	if err != nil {
+		return err
+	}
+
+	var user User
+	if jsb := bucket.Get(key); jsb != nil {
+		if err = json.Unmarshal(jsb, &user); err != nil {
+			return err
+		}
+	}
+	handler(&user)
+
+	jsb, err := json.Marshal(&user)
+	if err != nil {
+		return err
+	}
+	return bucket.Put(key, jsb)
+}
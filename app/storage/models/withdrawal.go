@@ -0,0 +1,339 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"luckybot/app/fmath"
+	"luckybot/app/storage"
+)
+
+// WithdrawState 提现状态
+type WithdrawState int
+
+const (
+	_                      WithdrawState = iota
+	WithdrawStatePending                 // 等待处理
+	WithdrawStateSubmitted               // 已提交脚本
+	WithdrawStateConfirmed               // 提现成功
+	WithdrawStateFailed                  // 提现失败
+)
+
+// Withdrawal 提现信息
+type Withdrawal struct {
+	ID        uint64        `json:"id"`              // 提现ID
+	UserID    int64         `json:"user_id"`         // 用户ID
+	Symbol    string        `json:"symbol"`          // 货币符号
+	Address   string        `json:"address"`         // 提现地址
+	Amount    *fmath.Amount `json:"amount"`          // 提现金额
+	Fee       *fmath.Amount `json:"fee"`             // 手续费
+	State     WithdrawState `json:"state"`           // 提现状态
+	TxID      string        `json:"txid,omitempty"`  // 交易ID
+	Error     string        `json:"error,omitempty"` // 错误信息
+	CreatedAt int64         `json:"created_at"`      // 创建时间
+	UpdatedAt int64         `json:"updated_at"`      // 更新时间
+}
+
+var (
+	// ErrNoSuchWithdrawal 没有此提现
+	ErrNoSuchWithdrawal = errors.New("no such withdrawal")
+	// ErrWithdrawalState 提现状态错误
+	ErrWithdrawalState = errors.New("invalid withdrawal state")
+)
+
+// ********************** 结构图 **********************
+// {
+//	"withdrawals": {
+// 		<seq>: Withdrawal	// 提现信息
+//	}
+// }
+// ***************************************************
+
+// WithdrawalModel 提现模型
+type WithdrawalModel struct {
+}
+
+// NewWithdrawal 创建提现
+func (model *WithdrawalModel) NewWithdrawal(withdrawal *Withdrawal) (*Withdrawal, error) {
+	err := storage.DB.Update(func(tx *bolt.Tx) error {
+		// 锁定提现资金
+		accountModel := AccountModel{}
+		total := fmath.Add(withdrawal.Amount, withdrawal.Fee)
+		_, err := accountModel.lockAccount(tx, withdrawal.UserID, withdrawal.Symbol, total, &Version{
+			Locked:     withdrawal.Amount,
+			Fee:        withdrawal.Fee,
+			Reason:     ReasonWithdraw,
+			RefAddress: &withdrawal.Address,
+		})
+		if err != nil {
+			return err
+		}
+
+		bucket, err := storage.EnsureBucketExists(tx, "withdrawals")
+		if err != nil {
+			return err
+		}
+
+		seq, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		withdrawal.ID = seq
+		withdrawal.State = WithdrawStatePending
+		withdrawal.CreatedAt = time.Now().UTC().Unix()
+		withdrawal.UpdatedAt = withdrawal.CreatedAt
+		jsb, err := json.Marshal(withdrawal)
+		if err != nil {
+			return err
+		}
+		return bucket.Put([]byte(strconv.FormatUint(seq, 10)), jsb)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return withdrawal, nil
+}
+
+// GetWithdrawal 获取提现信息
+func (model *WithdrawalModel) GetWithdrawal(id uint64) (*Withdrawal, error) {
+	var withdrawal Withdrawal
+	err := storage.DB.View(func(tx *bolt.Tx) error {
+		bucket, err := storage.GetBucketIfExists(tx, "withdrawals")
+		if err != nil {
+			return err
+		}
+
+		jsb := bucket.Get([]byte(strconv.FormatUint(id, 10)))
+		if jsb == nil {
+			return ErrNoSuchWithdrawal
+		}
+
+		if err = json.Unmarshal(jsb, &withdrawal); err != nil {
+			return err
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return &withdrawal, nil
+}
+
+// SetState 设置提现状态
+func (model *WithdrawalModel) SetState(id uint64, from []WithdrawState, to WithdrawState,
+	txid, reason string) (*Withdrawal, error) {
+
+	var withdrawal *Withdrawal
+	err := storage.DB.Update(func(tx *bolt.Tx) error {
+		var err error
+		withdrawal, err = model.setState(tx, id, from, to, txid, reason)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return withdrawal, nil
+}
+
+// Confirm 确认提现并扣除锁定资产
+func (model *WithdrawalModel) Confirm(id uint64, txid string) (*Withdrawal, *Version, error) {
+	var version *Version
+	var withdrawal *Withdrawal
+	err := storage.DB.Update(func(tx *bolt.Tx) error {
+		var err error
+		withdrawal, err = model.setState(tx, id, []WithdrawState{WithdrawStateSubmitted},
+			WithdrawStateConfirmed, txid, "")
+		if err != nil {
+			return err
+		}
+
+		total := fmath.Add(withdrawal.Amount, withdrawal.Fee)
+		version = &Version{
+			Balance:    fmath.Neg(total),
+			Locked:     fmath.Neg(withdrawal.Amount),
+			Fee:        withdrawal.Fee,
+			Reason:     ReasonWithdrawSuccess,
+			RefAddress: &withdrawal.Address,
+			RefTxID:    &txid,
+		}
+		accountModel := AccountModel{}
+		_, err = accountModel.withdrawAccount(tx, withdrawal.UserID, withdrawal.Symbol, total, version)
+		return err
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+	return withdrawal, version, nil
+}
+
+// Refund 提现失败并退还锁定资产
+func (model *WithdrawalModel) Refund(id uint64, from []WithdrawState, reason string) (*Withdrawal, *Version, error) {
+	var version *Version
+	var withdrawal *Withdrawal
+	err := storage.DB.Update(func(tx *bolt.Tx) error {
+		var err error
+		withdrawal, err = model.setState(tx, id, from, WithdrawStateFailed, "", reason)
+		if err != nil {
+			return err
+		}
+
+		total := fmath.Add(withdrawal.Amount, withdrawal.Fee)
+		version = &Version{
+			Locked:     fmath.Neg(withdrawal.Amount),
+			Fee:        withdrawal.Fee,
+			Reason:     ReasonWithdrawFailure,
+			RefAddress: &withdrawal.Address,
+		}
+		accountModel := AccountModel{}
+		_, err = accountModel.unlockAccount(tx, withdrawal.UserID, withdrawal.Symbol, total, version)
+		return err
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+	return withdrawal, version, nil
+}
+
+// 更新提现状态
+func (model *WithdrawalModel) setState(tx *bolt.Tx, id uint64, from []WithdrawState, to WithdrawState,
+	txid, reason string) (*Withdrawal, error) {
+
+	bucket, err := storage.GetBucketIfExists(tx, "withdrawals")
+	if err != nil {
+		return nil, err
+	}
+
+	key := []byte(strconv.FormatUint(id, 10))
+	jsb := bucket.Get(key)
+	if jsb == nil {
+		return nil, ErrNoSuchWithdrawal
+	}
+
+	var withdrawal Withdrawal
+	if err = json.Unmarshal(jsb, &withdrawal); err != nil {
+		return nil, err
+	}
+
+	// 检查当前状态
+	matched := false
+	for _, state := range from {
+		if withdrawal.State == state {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return nil, ErrWithdrawalState
+	}
+
+	// 更新提现状态
+	withdrawal.State = to
+	withdrawal.UpdatedAt = time.Now().UTC().Unix()
+	if len(txid) > 0 {
+		withdrawal.TxID = txid
+	}
+	if len(reason) > 0 {
+		withdrawal.Error = reason
+	}
+
+	jsb, err = json.Marshal(&withdrawal)
+	if err != nil {
+		return nil, err
+	}
+	if err = bucket.Put(key, jsb); err != nil {
+		return nil, err
+	}
+	return &withdrawal, nil
+}
+
+// GetWithdrawals 获取提现列表
+func (model *WithdrawalModel) GetWithdrawals(state WithdrawState, offset, limit uint,
+	reverse bool) ([]*Withdrawal, int, error) {
+
+	sum := 0
+	withdrawals := make([]*Withdrawal, 0)
+	err := storage.DB.View(func(tx *bolt.Tx) error {
+		bucket, err := storage.GetBucketIfExists(tx, "withdrawals")
+		if err != nil {
+			if err != storage.ErrNoBucket {
+				return err
+			}
+			return nil
+		}
+
+		filter := func(jsb []byte) error {
+			var withdrawal Withdrawal
+			if err := json.Unmarshal(jsb, &withdrawal); err != nil {
+				return err
+			}
+			if state != 0 && withdrawal.State != state {
+				return nil
+			}
+
+			if uint(sum) >= offset && uint(len(withdrawals)) < limit {
+				withdrawals = append(withdrawals, &withdrawal)
+			}
+			sum++
+			return nil
+		}
+
+		if reverse {
+			for i := bucket.Sequence(); i >= uint64(1); i-- {
+				jsb := bucket.Get([]byte(strconv.FormatUint(i, 10)))
+				if jsb == nil {
+					continue
+				}
+				if err = filter(jsb); err != nil {
+					return err
+				}
+			}
+		} else {
+			for i := uint64(1); i <= bucket.Sequence(); i++ {
+				jsb := bucket.Get([]byte(strconv.FormatUint(i, 10)))
+				if jsb == nil {
+					continue
+				}
+				if err = filter(jsb); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, 0, err
+	}
+	return withdrawals, sum, nil
+}
+
+// Foreach 遍历提现列表
+func (model *WithdrawalModel) Foreach(callback func(*Withdrawal)) error {
+	return storage.DB.View(func(tx *bolt.Tx) error {
+		bucket, err := storage.GetBucketIfExists(tx, "withdrawals")
+		if err != nil {
+			return err
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			var withdrawal Withdrawal
+			if err := json.Unmarshal(v, &withdrawal); err != nil {
+				return nil
+			}
+
+			if callback != nil {
+				callback(&withdrawal)
+			}
+			return nil
+		})
+	})
+}
@@ -3,6 +3,7 @@ package storage
 import (
 	"errors"
 	"io"
+	"time"
 
 	"github.com/boltdb/bolt"
 )
@@ -22,6 +23,20 @@ func Connect(path string) error {
 	return err
 }
 
+// ConnectTimeout 连接到数据库, 数据库被占用时超时返回
+func ConnectTimeout(path string, timeout time.Duration) error {
+	var err error
+	DB, err = bolt.Open(path, 0600, &bolt.Options{Timeout: timeout})
+	return err
+}
+
+// ConnectReadOnly 以只读方式连接到数据库
+func ConnectReadOnly(path string) error {
+	var err error
+	DB, err = bolt.Open(path, 0600, &bolt.Options{ReadOnly: true, Timeout: time.Second})
+	return err
+}
+
 // Close 关闭连接
 func Close() error {
 	return DB.Close()
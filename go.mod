@@ -13,6 +13,7 @@ require (
 	github.com/vrecan/death v3.0.1+incompatible
 	github.com/yuin/gopher-lua v0.0.0-20191220021717-ab39c6098bdb
 	github.com/zhangpanyi/basebot v0.0.0-20180904234143-a157c3633215
+	golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9
 	gopkg.in/natefinch/lumberjack.v2 v2.0.0 // indirect
 	gopkg.in/yaml.v2 v2.2.8
 )
@@ -2,12 +2,14 @@ package main
 
 import (
 	"net/http"
+	"os"
 	"strconv"
 	"syscall"
 
 	"github.com/gorilla/mux"
 	"github.com/vrecan/death"
 	"github.com/zhangpanyi/basebot/logger"
+	"github.com/zhangpanyi/basebot/telegram/methods"
 	"github.com/zhangpanyi/basebot/telegram/updater"
 	"luckybot/app/admin"
 	"luckybot/app/config"
@@ -16,17 +18,31 @@ import (
 	"luckybot/app/logic/botext"
 	"luckybot/app/logic/context"
 	"luckybot/app/logic/deposit"
+	"luckybot/app/logic/handlers"
 	"luckybot/app/logic/pusher"
 	"luckybot/app/logic/scriptengine"
+	"luckybot/app/logic/withdraw"
+	"luckybot/app/luaglue"
 	"luckybot/app/monitor"
 	poll "luckybot/app/poller"
 	"luckybot/app/storage"
+	"luckybot/app/storage/models"
 )
 
 func main() {
 	// 加载配置文件
 	config.LoadConfig("server.yml")
 
+	// 执行对账命令
+	if len(os.Args) > 1 && os.Args[1] == "verify" {
+		os.Exit(verify())
+	}
+
+	// 执行管理员命令
+	if len(os.Args) > 1 && os.Args[1] == "admin" {
+		os.Exit(adminCommand(os.Args[2:]))
+	}
+
 	// 初始化日志库
 	serveCfg := config.GetServe()
 	logger.CreateLoggerOnce(logger.DebugLevel, logger.InfoLevel)
@@ -37,6 +53,11 @@ func main() {
 		logger.Panic(err)
 	}
 
+	// 迁移历史数据
+	if err = models.Migrate(serveCfg.Precision); err != nil {
+		logger.Panic(err)
+	}
+
 	// 状态上下文管理
 	context.CreateManagerOnce(16)
 
@@ -44,11 +65,29 @@ func main() {
 	future.NewFutureManagerOnce()
 
 	// 创建Lua脚本引擎
+	luaglue.SetDepositCreditor(deposit.CreditFromScript)
 	scriptengine.NewScriptEngineOnce()
 
 	// 创建机器人轮询器
+	var bot *methods.BotExt
+	router := mux.NewRouter()
 	poller := poll.NewPoller(serveCfg.APIAccess)
-	bot, err := poller.StartPoll(serveCfg.Token, logic.NewUpdate)
+	if serveCfg.Mode == config.ModeWebhook {
+		options := poll.WebhookOptions{
+			URL:            serveCfg.Webhook.URL,
+			SecretToken:    serveCfg.Webhook.SecretToken,
+			MaxConnections: serveCfg.Webhook.MaxConnections,
+		}
+		if len(serveCfg.Webhook.Certificate) > 0 {
+			options.Certificate, err = os.ReadFile(serveCfg.Webhook.Certificate)
+			if err != nil {
+				logger.Panic(err)
+			}
+		}
+		bot, err = poller.StartWebhook(serveCfg.Token, router, &options, logic.NewUpdate)
+	} else {
+		bot, err = poller.StartPoll(serveCfg.Token, logic.NewUpdate)
+	}
 	if err != nil {
 		logger.Panic(err)
 	}
@@ -57,22 +96,32 @@ func main() {
 
 	// 启动红包检查器
 	pool := updater.NewPool(64)
-	monitor.StartChecking(bot, pool)
+	monitor.StartChecking(bot, pool, handlers.RefreshLuckyMoney)
 
 	// 运行推送服务
 	pusher.ServiceStart(pool)
 
+	// 启动提现处理器
+	withdraw.StartWorkerOnce()
+
 	// 启动HTTP服务器
-	router := mux.NewRouter()
 	admin.InitRoute(router)
-	router.HandleFunc("/deposit", deposit.HandleDeposit)
+	router.HandleFunc("/deposit", deposit.HandleDeposit).Methods("POST")
+	if len(serveCfg.Deposit.Secret) == 0 {
+		logger.Warnf("Deposit secret not configured, all deposit requests will be rejected")
+	}
 	addr := serveCfg.Host + ":" + strconv.Itoa(serveCfg.Port)
 	go func() {
 		s := &http.Server{
 			Addr:    addr,
 			Handler: router,
 		}
-		if err = s.ListenAndServe(); err != nil {
+		if serveCfg.TLS.Enabled() {
+			err = s.ListenAndServeTLS(serveCfg.TLS.CertFile, serveCfg.TLS.KeyFile)
+		} else {
+			err = s.ListenAndServe()
+		}
+		if err != nil {
 			logger.Panicf("Failed to listen and serve, %v, %v", addr, err)
 		}
 	}()
@@ -1,6 +1,24 @@
+-- 可用模块:
+--   http   get(url), post(url, content_type, body) 返回 resp, err
+--   json   dump(table), parse(string) 返回 value, err
+--   crypto sha256/sha512(data[, raw]), hmac_sha256/hmac_sha512(key, data[, raw]),
+--          hex_encode/hex_decode, base58_encode/base58_decode, 默认返回十六进制字符串
+--   time   now(), millis(), format(ts[, layout]), parse(value[, layout]), 使用Go时间格式
+--   store  get/set/delete(namespace, key[, value]), keys(namespace), 数据保存在数据库中
+--   log    debug/info/warn/error(...)
+--   bot    credit_deposit(txid, height, from, to, asset, amount, memo) 返回 ok, err
+--          与 /deposit 接口相同的去重、valid_transaction 验证、入账和通知流程,
+--          可在 on_tick 中扫描本地节点后直接入账
 local http = require("http")
 local json = require("json")
 
+-- 脚本加载自检(可选), 修改脚本后只有自检通过才会切换到新脚本
+-- @return ok <boolean or nil> 返回false表示自检失败
+-- @return reason <string or nil> 失败原因
+function on_init()
+    return true
+end
+
 -- 时钟事件
 -- @param delaytime <number>
 function on_tick(delaytime)
@@ -8,16 +26,18 @@ end
 
 -- 账户是否有效
 -- @param address <string> 地址
+-- @param symbol <string> 货币符号
 -- @return <boolean>
-function valid_address(address)
+function valid_address(address, symbol)
     return true
 end
 
 -- 获取充值地址
 -- @param userid <string> 用户ID
+-- @param symbol <string> 货币符号
 -- @return address <string>
 -- @return memo <string or nil>
-function deposit_address(userid)
+function deposit_address(userid, symbol)
     return 'test', userid
 end
 
@@ -25,7 +45,9 @@ end
 -- @param to <string> 目标地址
 -- @param symbol <string> 货币符号
 -- @param amount <string> 提现金额
--- @param future <Future> 处理完成必须调用set_result(txid, error)方法
+-- @param future <Future> 必须在本次调用返回前调用set_result(txid, error)方法,
+--                        全局变量不在虚拟机之间共享, 不能保存future稍后在on_tick中设置结果,
+--                        返回时未设置结果视为结果未知, 等待管理员重试或退还
 function on_withdraw(to, symbol, amount, future)
     future:set_result(nil, 'unrealized')
 end
@@ -41,3 +63,50 @@ end
 function valid_transaction(txid, from, to, symbol, amount, memo)
     return true
 end
+
+-- 拆分红包金额(分配算法配置为lua时调用)
+-- @param amount <string> 红包金额(最小单位)
+-- @param number <number> 红包个数
+-- @return <table> 每份金额(最小单位)列表, 总和必须等于红包金额且每份至少为1
+function distribute(amount, number)
+    local total = tonumber(amount)
+    local unit = math.floor(total / number)
+    local result = {}
+    for i = 1, number do
+        result[i] = string.format('%d', unit)
+    end
+    result[1] = string.format('%d', total - unit * (number - 1))
+    return result
+end
+
+-- 红包事件参数(table), 以下钩子函数共用
+--   id, sn, sender_id <string> 红包ID、编号、发送者ID
+--   sender_name, asset, message, scope, distributor <string>
+--   amount <string> 红包金额(随机红包为总额, 普通红包为单个金额)
+--   value <string or nil> 单个红包金额(仅普通红包)
+--   number, timestamp, activate_at, expire_at <number>
+--   lucky, password, exclusive <boolean> 是否随机、口令、专属红包
+
+-- 红包创建事件(资金已锁定)
+-- @param event <table> 红包事件参数
+function on_lucky_money_created(event)
+end
+
+-- 红包领取事件(领取条件检查通过后、领取前调用, 并发领取时仍可能因为已领完而失败)
+-- @param event <table> 红包事件参数, 另含 received <number> 已领取个数,
+--                     user_id, first_name, user_name, chat_instance <string> 领取者信息
+-- @return allow <boolean or nil> 返回false拒绝领取, nil视为允许, 脚本出错或超时时拒绝领取
+-- @return bonus <string or nil> 额外发放给领取者的奖励金额, 与领取在同一事务中入账, 记为系统发放
+function on_lucky_money_received(event)
+    return true, nil
+end
+
+-- 红包过期事件(剩余金额已退还)
+-- @param event <table> 红包事件参数, 另含 refund <string> 退还金额
+function on_lucky_money_expired(event)
+end
+
+-- 充值到账事件
+-- @param event <table> txid, from, to, asset, amount, memo, user_id <string>, height <number>
+function on_deposit(event)
+end
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+
+	"luckybot/app/config"
+	"luckybot/app/storage"
+	"luckybot/app/storage/models"
+)
+
+// 账目对账
+func verify() int {
+	serveCfg := config.GetServe()
+	if err := storage.ConnectReadOnly(serveCfg.BolTDBPath); err != nil {
+		fmt.Printf("Failed to open database, %v, use /admin/audit while server is running\n", err)
+		return 2
+	}
+	defer storage.Close()
+
+	report, err := models.Audit(serveCfg.Precision, int64(serveCfg.Expire))
+	if err != nil {
+		fmt.Printf("Failed to audit, %v\n", err)
+		return 2
+	}
+
+	for _, discrepancy := range report.Discrepancies {
+		fmt.Println(discrepancy.String())
+	}
+	fmt.Printf("accounts: %d, versions: %d, lucky moneys: %d, deposits: %d, withdrawals: %d, discrepancies: %d\n",
+		report.Accounts, report.Versions, report.LuckyMoneys, report.Deposits, report.Withdrawals,
+		len(report.Discrepancies))
+	if len(report.Discrepancies) > 0 {
+		return 1
+	}
+	return 0
+}